@@ -3,21 +3,30 @@ package google
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
-type ComputeApiVersion uint8
+type ApiVersion uint8
 
 const (
-	v1 ComputeApiVersion = iota
+	v1 ApiVersion = iota
 	v0beta
+	v1beta1
 )
 
-var OrderedComputeApiVersions = []ComputeApiVersion{
+var OrderedComputeApiVersions = []ApiVersion{
 	v0beta,
 	v1,
 }
 
+var OrderedContainerApiVersions = []ApiVersion{
+	v1beta1,
+	v1,
+}
+
 // Convert between two types by converting to/from JSON. Intended to switch
 // between multiple API versions, as they are strict supersets of one another.
 // Convert loses information about ForceSendFields and NullFields.
@@ -41,23 +50,41 @@ type TerraformResourceData interface {
 }
 
 // Compare the fields set in schema against a list of features and their versions to determine
-// what version of the API is required in order to manage the resource.
-func getComputeApiVersion(d TerraformResourceData, resourceVersion ComputeApiVersion, features []Feature) ComputeApiVersion {
-	versions := map[ComputeApiVersion]struct{}{resourceVersion: struct{}{}}
+// what version of the Compute API is required in order to manage the resource.
+func getComputeApiVersion(d TerraformResourceData, resourceVersion ApiVersion, features []Feature) ApiVersion {
+	return getApiVersion(d, resourceVersion, features, OrderedComputeApiVersions)
+}
+
+// Compare the fields set in schema against a list of features and their version, and a
+// list of features that exist at the base resource version that can only be update at some other
+// version, to determine what version of the Compute API is required in order to update the resource.
+func getComputeApiVersionUpdate(d TerraformResourceData, resourceVersion ApiVersion, features, updateOnlyFields []Feature) ApiVersion {
+	return getApiVersionUpdate(d, resourceVersion, features, updateOnlyFields, OrderedComputeApiVersions)
+}
+
+// Same as getComputeApiVersion, for the Container API.
+func getContainerApiVersion(d TerraformResourceData, resourceVersion ApiVersion, features []Feature) ApiVersion {
+	return getApiVersion(d, resourceVersion, features, OrderedContainerApiVersions)
+}
+
+// Same as getComputeApiVersionUpdate, for the Container API.
+func getContainerApiVersionUpdate(d TerraformResourceData, resourceVersion ApiVersion, features, updateOnlyFields []Feature) ApiVersion {
+	return getApiVersionUpdate(d, resourceVersion, features, updateOnlyFields, OrderedContainerApiVersions)
+}
+
+func getApiVersion(d TerraformResourceData, resourceVersion ApiVersion, features []Feature, orderedVersions []ApiVersion) ApiVersion {
+	versions := map[ApiVersion]struct{}{resourceVersion: struct{}{}}
 	for _, feature := range features {
 		if feature.InUseByDefault(d) {
 			versions[feature.Version] = struct{}{}
 		}
 	}
 
-	return maxVersion(versions)
+	return maxVersion(versions, orderedVersions)
 }
 
-// Compare the fields set in schema against a list of features and their version, and a
-// list of features that exist at the base resource version that can only be update at some other
-// version, to determine what version of the API is required in order to update the resource.
-func getComputeApiVersionUpdate(d TerraformResourceData, resourceVersion ComputeApiVersion, features, updateOnlyFields []Feature) ComputeApiVersion {
-	versions := map[ComputeApiVersion]struct{}{resourceVersion: struct{}{}}
+func getApiVersionUpdate(d TerraformResourceData, resourceVersion ApiVersion, features, updateOnlyFields []Feature, orderedVersions []ApiVersion) ApiVersion {
+	versions := map[ApiVersion]struct{}{resourceVersion: struct{}{}}
 
 	for _, feature := range features {
 		if feature.InUseByUpdate(d) {
@@ -71,12 +98,12 @@ func getComputeApiVersionUpdate(d TerraformResourceData, resourceVersion Compute
 		}
 	}
 
-	return maxVersion(versions)
+	return maxVersion(versions, orderedVersions)
 }
 
-// A field of a resource and the version of the Compute API required to use it.
+// A field of a resource and the version of the API required to use it.
 type Feature struct {
-	Version ComputeApiVersion
+	Version ApiVersion
 	// Path to the beta field.
 	//
 	// The feature is considered to be in-use if the field referenced by "Item" is set in the state.
@@ -84,8 +111,8 @@ type Feature struct {
 	// - a beta field at the top-level (e.g. "min_cpu_platform").
 	// - a beta field nested inside a list (e.g. "network_interface.*.alias_ip_range" is considered to be
 	// 		in-use if the "alias_ip_range" field is set in the state for any of the network interfaces).
-	//
-	// Note: beta field nested inside a SET are NOT supported at the moment.
+	// - a beta field nested inside a set (e.g. "backend.*.max_connections"). The "*" is expanded to the
+	// 		hash code of each element of the set.
 	Item string
 
 	// Optional, only set if your field has a default value.
@@ -137,6 +164,20 @@ func inUseBy(d TerraformResourceData, path string, defaultValue interface{}, inU
 	prefix := path[0:pos]
 	suffix := path[pos+1:]
 
+	// Elements of a set are addressed by their hash code rather than by their index.
+	if v, ok := d.GetOk(strings.TrimSuffix(prefix, ".")); ok {
+		if set, ok := v.(*schema.Set); ok {
+			for _, item := range set.List() {
+				nestedPath := fmt.Sprintf("%s%s%s", prefix, setItemCode(set, item), suffix)
+				if inUseBy(d, nestedPath, defaultValue, inUseFunc) {
+					return true
+				}
+			}
+
+			return false
+		}
+	}
+
 	v, ok := d.GetOk(prefix + "#")
 
 	if !ok {
@@ -154,13 +195,23 @@ func inUseBy(d TerraformResourceData, path string, defaultValue interface{}, inU
 	return false
 }
 
-func maxVersion(versionsInUse map[ComputeApiVersion]struct{}) ComputeApiVersion {
-	for _, version := range OrderedComputeApiVersions {
+// Returns the code used in state keys to address an element of a set. This must match the code
+// computed by schema.Set, which never uses negative values.
+func setItemCode(set *schema.Set, item interface{}) string {
+	code := set.F(item)
+	if code < 0 {
+		code = -code
+	}
+	return strconv.Itoa(code)
+}
+
+func maxVersion(versionsInUse map[ApiVersion]struct{}, orderedVersions []ApiVersion) ApiVersion {
+	for _, version := range orderedVersions {
 		if _, ok := versionsInUse[version]; ok {
 			return version
 		}
 	}
 
 	// Fallback to the final, most stable version
-	return OrderedComputeApiVersions[len(OrderedComputeApiVersions)-1]
+	return orderedVersions[len(orderedVersions)-1]
 }
@@ -1,11 +1,15 @@
 package google
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
 
 type ExpectedApiVersions struct {
-	Create     ComputeApiVersion
-	ReadDelete ComputeApiVersion
-	Update     ComputeApiVersion
+	Create     ApiVersion
+	ReadDelete ApiVersion
+	Update     ApiVersion
 }
 
 func TestComputeApiVersion(t *testing.T) {
@@ -158,6 +162,68 @@ func TestComputeApiVersion(t *testing.T) {
 				Update:     betaVersion,
 			},
 		},
+		"set nested beta field not set": {
+			Features: []Feature{{Version: betaVersion, Item: "set_field.*.beta_nested_field"}},
+			FieldsInSchema: map[string]interface{}{
+				"set_field": schema.NewSet(hashSetItemId, []interface{}{
+					map[string]interface{}{"id": 12},
+					map[string]interface{}{"id": -34},
+				}),
+				"set_field.12.normal_field": "foo",
+				"set_field.34.normal_field": "bar",
+			},
+			ExpectedApiVersions: ExpectedApiVersions{
+				Create:     baseVersion,
+				ReadDelete: baseVersion,
+				Update:     baseVersion,
+			},
+		},
+		"set nested beta field set": {
+			Features: []Feature{{Version: betaVersion, Item: "set_field.*.beta_nested_field"}},
+			FieldsInSchema: map[string]interface{}{
+				"set_field": schema.NewSet(hashSetItemId, []interface{}{
+					map[string]interface{}{"id": 12},
+					map[string]interface{}{"id": -34},
+				}),
+				"set_field.12.normal_field":      "foo",
+				"set_field.34.beta_nested_field": "bar",
+			},
+			ExpectedApiVersions: ExpectedApiVersions{
+				Create:     betaVersion,
+				ReadDelete: betaVersion,
+				Update:     betaVersion,
+			},
+		},
+		"set nested in list beta field set": {
+			Features: []Feature{{Version: betaVersion, Item: "list_field.*.set_field.*.beta_nested_field"}},
+			FieldsInSchema: map[string]interface{}{
+				"list_field.#": 1,
+				"list_field.0.set_field": schema.NewSet(hashSetItemId, []interface{}{
+					map[string]interface{}{"id": 56},
+				}),
+				"list_field.0.set_field.56.beta_nested_field": "foo",
+			},
+			ExpectedApiVersions: ExpectedApiVersions{
+				Create:     betaVersion,
+				ReadDelete: betaVersion,
+				Update:     betaVersion,
+			},
+		},
+		"set nested beta field has default value": {
+			Features: []Feature{{Version: betaVersion, Item: "set_field.*.beta_nested_field", DefaultValue: "baz"}},
+			FieldsInSchema: map[string]interface{}{
+				"set_field": schema.NewSet(hashSetItemId, []interface{}{
+					map[string]interface{}{"id": 12},
+				}),
+				"set_field.12.beta_nested_field": "baz",
+			},
+			UpdatedFields: []string{"set_field.12.beta_nested_field"},
+			ExpectedApiVersions: ExpectedApiVersions{
+				Create:     baseVersion,
+				ReadDelete: baseVersion,
+				Update:     betaVersion,
+			},
+		},
 	}
 
 	for tn, tc := range cases {
@@ -203,6 +269,10 @@ func TestComputeApiVersion(t *testing.T) {
 	}
 }
 
+func hashSetItemId(v interface{}) int {
+	return v.(map[string]interface{})["id"].(int)
+}
+
 type ResourceDataMock struct {
 	FieldsInSchema      map[string]interface{}
 	FieldsWithHasChange []string
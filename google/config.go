@@ -24,6 +24,7 @@ import (
 	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/container/v1"
+	containerBeta "google.golang.org/api/container/v1beta1"
 	"google.golang.org/api/dataproc/v1"
 	"google.golang.org/api/dns/v1"
 	"google.golang.org/api/iam/v1"
@@ -35,6 +36,7 @@ import (
 	"google.golang.org/api/spanner/v1"
 	"google.golang.org/api/sqladmin/v1beta4"
 	"google.golang.org/api/storage/v1"
+	"google.golang.org/api/storagetransfer/v1"
 )
 
 // Config is the configuration structure used to instantiate the Google
@@ -48,6 +50,7 @@ type Config struct {
 	clientCompute                *compute.Service
 	clientComputeBeta            *computeBeta.Service
 	clientContainer              *container.Service
+	clientContainerBeta          *containerBeta.Service
 	clientDataproc               *dataproc.Service
 	clientDns                    *dns.Service
 	clientKms                    *cloudkms.Service
@@ -59,6 +62,7 @@ type Config struct {
 	clientSpanner                *spanner.Service
 	clientSourceRepo             *sourcerepo.Service
 	clientStorage                *storage.Service
+	clientStorageTransfer        *storagetransfer.Service
 	clientSqlAdmin               *sqladmin.Service
 	clientIAM                    *iam.Service
 	clientServiceMan             *servicemanagement.APIService
@@ -152,6 +156,13 @@ func (c *Config) loadAndValidate() error {
 	}
 	c.clientContainer.UserAgent = userAgent
 
+	log.Printf("[INFO] Instantiating GKE Beta client...")
+	c.clientContainerBeta, err = containerBeta.New(client)
+	if err != nil {
+		return err
+	}
+	c.clientContainerBeta.UserAgent = userAgent
+
 	log.Printf("[INFO] Instantiating Google Cloud DNS client...")
 	c.clientDns, err = dns.New(client)
 	if err != nil {
@@ -180,6 +191,13 @@ func (c *Config) loadAndValidate() error {
 	}
 	c.clientStorage.UserAgent = userAgent
 
+	log.Printf("[INFO] Instantiating Google Storage Transfer Client...")
+	c.clientStorageTransfer, err = storagetransfer.New(client)
+	if err != nil {
+		return err
+	}
+	c.clientStorageTransfer.UserAgent = userAgent
+
 	log.Printf("[INFO] Instantiating Google SqlAdmin Client...")
 	c.clientSqlAdmin, err = sqladmin.New(client)
 	if err != nil {
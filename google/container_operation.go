@@ -7,13 +7,14 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"google.golang.org/api/container/v1"
+	containerBeta "google.golang.org/api/container/v1beta1"
 )
 
 type ContainerOperationWaiter struct {
-	Service *container.Service
-	Op      *container.Operation
-	Project string
-	Zone    string
+	Service  *container.Service
+	Op       *container.Operation
+	Project  string
+	Location string
 }
 
 func (w *ContainerOperationWaiter) Conf() *resource.StateChangeConf {
@@ -26,8 +27,9 @@ func (w *ContainerOperationWaiter) Conf() *resource.StateChangeConf {
 
 func (w *ContainerOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		resp, err := w.Service.Projects.Zones.Operations.Get(
-			w.Project, w.Zone, w.Op.Name).Do()
+		name := fmt.Sprintf("projects/%s/locations/%s/operations/%s",
+			w.Project, w.Location, w.Op.Name)
+		resp, err := w.Service.Projects.Locations.Operations.Get(name).Do()
 
 		if err != nil {
 			return nil, "", err
@@ -43,12 +45,12 @@ func (w *ContainerOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
 	}
 }
 
-func containerOperationWait(config *Config, op *container.Operation, project, zone, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+func containerOperationWait(config *Config, op *container.Operation, project, location, activity string, timeoutMinutes, minTimeoutSeconds int) error {
 	w := &ContainerOperationWaiter{
-		Service: config.clientContainer,
-		Op:      op,
-		Project: project,
-		Zone:    zone,
+		Service:  config.clientContainer,
+		Op:       op,
+		Project:  project,
+		Location: location,
 	}
 
 	state := w.Conf()
@@ -61,3 +63,29 @@ func containerOperationWait(config *Config, op *container.Operation, project, zo
 
 	return nil
 }
+
+func containerBetaOperationWait(config *Config, op *containerBeta.Operation, project, location, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+	// Operations are shared between API versions, so they can be polled with the v1 client.
+	opV1 := &container.Operation{}
+	err := Convert(op, opV1)
+	if err != nil {
+		return err
+	}
+
+	return containerOperationWait(config, opV1, project, location, activity, timeoutMinutes, minTimeoutSeconds)
+}
+
+func containerSharedOperationWait(config *Config, op interface{}, project, location, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+	if op == nil {
+		panic("Attempted to wait on an Operation that was nil.")
+	}
+
+	switch op.(type) {
+	case *container.Operation:
+		return containerOperationWait(config, op.(*container.Operation), project, location, activity, timeoutMinutes, minTimeoutSeconds)
+	case *containerBeta.Operation:
+		return containerBetaOperationWait(config, op.(*containerBeta.Operation), project, location, activity, timeoutMinutes, minTimeoutSeconds)
+	default:
+		panic("Attempted to wait on an Operation of unknown type.")
+	}
+}
@@ -0,0 +1,112 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleContainerCluster() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceContainerCluster().Schema)
+
+	// Set 'Required' schema elements
+	addRequiredFieldsToSchema(dsSchema, "name")
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "project", "zone", "region")
+
+	dsSchema["kubeconfig_raw"] = &schema.Schema{
+		Type:      schema.TypeString,
+		Computed:  true,
+		Sensitive: true,
+	}
+
+	return &schema.Resource{
+		Read:   datasourceContainerClusterRead,
+		Schema: dsSchema,
+	}
+}
+
+func datasourceContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
+
+	clusterName := d.Get("name").(string)
+	d.SetId(clusterName)
+
+	if err := resourceContainerClusterRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("Container Cluster %q not found in %s", clusterName, location)
+	}
+
+	kubeconfig, err := renderContainerClusterKubeconfig(&containerClusterKubeconfig{
+		Name:                 fmt.Sprintf("gke_%s_%s_%s", project, location, clusterName),
+		Endpoint:             d.Get("endpoint").(string),
+		ClusterCaCertificate: d.Get("master_auth.0.cluster_ca_certificate").(string),
+		ClientCertificate:    d.Get("master_auth.0.client_certificate").(string),
+		ClientKey:            d.Get("master_auth.0.client_key").(string),
+	})
+	if err != nil {
+		return err
+	}
+	d.Set("kubeconfig_raw", kubeconfig)
+
+	return nil
+}
+
+type containerClusterKubeconfig struct {
+	Name                 string
+	Endpoint             string
+	ClusterCaCertificate string
+	ClientCertificate    string
+	ClientKey            string
+}
+
+// Clusters without a client certificate authenticate through the gcp auth
+// provider built into kubectl, which uses the caller's gcloud credentials.
+var containerClusterKubeconfigTemplate = template.Must(template.New("kubeconfig").Parse(`apiVersion: v1
+kind: Config
+clusters:
+- name: {{.Name}}
+  cluster:
+    server: https://{{.Endpoint}}
+    certificate-authority-data: {{.ClusterCaCertificate}}
+contexts:
+- name: {{.Name}}
+  context:
+    cluster: {{.Name}}
+    user: {{.Name}}
+current-context: {{.Name}}
+users:
+- name: {{.Name}}
+  user:
+{{- if and .ClientCertificate .ClientKey}}
+    client-certificate-data: {{.ClientCertificate}}
+    client-key-data: {{.ClientKey}}
+{{- else}}
+    auth-provider:
+      name: gcp
+{{- end}}
+`))
+
+func renderContainerClusterKubeconfig(c *containerClusterKubeconfig) (string, error) {
+	var buf bytes.Buffer
+	if err := containerClusterKubeconfigTemplate.Execute(&buf, c); err != nil {
+		return "", fmt.Errorf("Error rendering kubeconfig: %s", err)
+	}
+	return buf.String(), nil
+}
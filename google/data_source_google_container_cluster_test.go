@@ -0,0 +1,195 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContainerClusterDatasource_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerClusterDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceGoogleContainerClusterCheck("data.google_container_cluster.kubes", "google_container_cluster.kubes"),
+					resource.TestCheckResourceAttrSet("data.google_container_cluster.kubes", "kubeconfig_raw"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerClusterDatasource_regional(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerClusterDatasourceRegionalConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceGoogleContainerClusterCheck("data.google_container_cluster.kubes", "google_container_cluster.kubes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleContainerClusterCheck(dataSourceName string, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("root module has no resource called %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		dsAttr := ds.Primary.Attributes
+		rsAttr := rs.Primary.Attributes
+
+		clusterAttrToCheck := []string{
+			"name",
+			"zone",
+			"region",
+			"additional_zones",
+			"addons_config",
+			"cluster_ipv4_cidr",
+			"description",
+			"enable_kubernetes_alpha",
+			"enable_legacy_abac",
+			"endpoint",
+			"instance_group_urls",
+			"ip_allocation_policy",
+			"logging_service",
+			"maintenance_policy",
+			"master_auth",
+			"master_auth.0.password",
+			"master_auth.0.username",
+			"master_auth.0.client_certificate",
+			"master_auth.0.client_key",
+			"master_auth.0.cluster_ca_certificate",
+			"master_authorized_networks_config",
+			"master_version",
+			"min_master_version",
+			"monitoring_service",
+			"network",
+			"network_policy",
+			"node_version",
+			"subnetwork",
+			"project",
+		}
+
+		for _, attr := range clusterAttrToCheck {
+			if dsAttr[attr] != rsAttr[attr] {
+				return fmt.Errorf(
+					"%s is %s; want %s",
+					attr,
+					dsAttr[attr],
+					rsAttr[attr],
+				)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestRenderContainerClusterKubeconfig(t *testing.T) {
+	cases := map[string]struct {
+		Config      containerClusterKubeconfig
+		Contains    []string
+		NotContains []string
+	}{
+		"client certificate": {
+			Config: containerClusterKubeconfig{
+				Name:                 "gke_my-project_us-central1-a_my-cluster",
+				Endpoint:             "10.0.0.1",
+				ClusterCaCertificate: "Y2EtY2VydA==",
+				ClientCertificate:    "Y2xpZW50LWNlcnQ=",
+				ClientKey:            "Y2xpZW50LWtleQ==",
+			},
+			Contains: []string{
+				"server: https://10.0.0.1\n",
+				"certificate-authority-data: Y2EtY2VydA==\n",
+				"current-context: gke_my-project_us-central1-a_my-cluster\n",
+				"client-certificate-data: Y2xpZW50LWNlcnQ=\n",
+				"client-key-data: Y2xpZW50LWtleQ==\n",
+			},
+			NotContains: []string{"auth-provider"},
+		},
+		"auth provider": {
+			Config: containerClusterKubeconfig{
+				Name:                 "gke_my-project_us-central1_my-cluster",
+				Endpoint:             "10.0.0.1",
+				ClusterCaCertificate: "Y2EtY2VydA==",
+			},
+			Contains: []string{
+				"server: https://10.0.0.1\n",
+				"  user:\n    auth-provider:\n      name: gcp\n",
+			},
+			NotContains: []string{"client-certificate-data", "client-key-data"},
+		},
+	}
+
+	for tn, tc := range cases {
+		kubeconfig, err := renderContainerClusterKubeconfig(&tc.Config)
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error: %s", tn, err)
+		}
+
+		for _, s := range tc.Contains {
+			if !strings.Contains(kubeconfig, s) {
+				t.Errorf("bad: %s, expected kubeconfig to contain %q, got:\n%s", tn, s, kubeconfig)
+			}
+		}
+		for _, s := range tc.NotContains {
+			if strings.Contains(kubeconfig, s) {
+				t.Errorf("bad: %s, expected kubeconfig not to contain %q, got:\n%s", tn, s, kubeconfig)
+			}
+		}
+	}
+}
+
+var testAccContainerClusterDatasourceConfig = fmt.Sprintf(`
+resource "google_container_cluster" "kubes" {
+	name               = "cluster-test-%s"
+	zone               = "us-central1-a"
+	initial_node_count = 1
+
+	master_auth {
+		username = "mr.yoda"
+		password = "adoy.rm"
+	}
+}
+
+data "google_container_cluster" "kubes" {
+	name = "${google_container_cluster.kubes.name}"
+	zone = "${google_container_cluster.kubes.zone}"
+}
+`, acctest.RandString(10))
+
+var testAccContainerClusterDatasourceRegionalConfig = fmt.Sprintf(`
+resource "google_container_cluster" "kubes" {
+	name               = "cluster-test-%s"
+	region             = "us-central1"
+	initial_node_count = 1
+}
+
+data "google_container_cluster" "kubes" {
+	name   = "${google_container_cluster.kubes.name}"
+	region = "${google_container_cluster.kubes.region}"
+}
+`, acctest.RandString(10))
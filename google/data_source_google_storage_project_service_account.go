@@ -0,0 +1,46 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleStorageProjectServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleStorageProjectServiceAccountRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"email_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleStorageProjectServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	serviceAccount, err := config.clientStorage.Projects.ServiceAccount.Get(project).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GCS service account for project %q", project))
+	}
+
+	d.Set("project", project)
+	d.Set("email_address", serviceAccount.EmailAddress)
+
+	d.SetId(serviceAccount.EmailAddress)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleStorageTransferProjectServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleStorageTransferProjectServiceAccountRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleStorageTransferProjectServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	serviceAccount, err := config.clientStorageTransfer.GoogleServiceAccounts.Get(project).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Storage Transfer service account for project %q", project))
+	}
+
+	d.Set("project", project)
+	d.Set("email", serviceAccount.AccountEmail)
+
+	d.SetId(serviceAccount.AccountEmail)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleStorageTransferProjectServiceAccount_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_storage_transfer_project_service_account.default"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleStorageTransferProjectServiceAccount_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "email"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckGoogleStorageTransferProjectServiceAccount_basic = `
+data "google_storage_transfer_project_service_account" "default" { }
+`
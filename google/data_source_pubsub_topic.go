@@ -0,0 +1,43 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGooglePubsubTopic() *schema.Resource {
+	dsSchema := datasourceSchemaFromResourceSchema(resourcePubsubTopic().Schema)
+
+	addRequiredFieldsToSchema(dsSchema, "name")
+	addOptionalFieldsToSchema(dsSchema, "project")
+
+	return &schema.Resource{
+		Read:   dataSourceGooglePubsubTopicRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGooglePubsubTopicRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	name := getComputedTopicName(project, d.Get("name").(string))
+	d.SetId(name)
+
+	if err := resourcePubsubTopicRead(d, meta); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return fmt.Errorf("Pubsub Topic %q not found", name)
+	}
+
+	d.Set("project", project)
+
+	return nil
+}
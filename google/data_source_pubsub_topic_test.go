@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGooglePubsubTopic_basic(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGooglePubsubTopic_basic(topic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.google_pubsub_topic.foo", "name", "google_pubsub_topic.foo", "name"),
+					resource.TestCheckResourceAttr("data.google_pubsub_topic.foo", "labels.foo", "bar"),
+					resource.TestCheckResourceAttr("data.google_pubsub_topic.foo", "message_storage_policy.0.allowed_persistence_regions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGooglePubsubTopic_basic(topic string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+
+	labels {
+		foo = "bar"
+	}
+
+	message_storage_policy {
+		allowed_persistence_regions = ["europe-west1"]
+	}
+}
+
+data "google_pubsub_topic" "foo" {
+	name = "${google_pubsub_topic.foo.name}"
+}`, topic)
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -29,8 +30,13 @@ import (
 )
 
 const gcsBaseUrl = "https://storage.googleapis.com"
+const gcsHost = "storage.googleapis.com"
 const googleCredentialsEnvVar = "GOOGLE_APPLICATION_CREDENTIALS"
 
+// V4 signatures can be valid for at most 7 days.
+const gcsV4MaxExpiration = 7 * 24 * time.Hour
+const gcsV4SigningAlgorithm = "GOOG4-RSA-SHA256"
+
 func dataSourceGoogleSignedUrl() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceGoogleSignedUrlRead,
@@ -75,6 +81,17 @@ func dataSourceGoogleSignedUrl() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"query_parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+			"signing_version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "v2",
+				ValidateFunc: validation.StringInSlice([]string{"v2", "v4"}, false),
+			},
 			"signed_url": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,6 +112,14 @@ func validateExtensionHeaders(v interface{}, k string) (ws []string, errors []er
 }
 
 func dataSourceGoogleSignedUrlRead(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("signing_version").(string) == "v4" {
+		return dataSourceGoogleSignedUrlV4Read(d, meta)
+	}
+
+	if v, ok := d.GetOk("query_parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		return fmt.Errorf("query_parameters can only be used with signing_version v4")
+	}
+
 	config := meta.(*Config)
 
 	// Build UrlData object from data source attributes
@@ -166,6 +191,56 @@ func dataSourceGoogleSignedUrlRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func dataSourceGoogleSignedUrlV4Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	duration, err := time.ParseDuration(d.Get("duration").(string))
+	if err != nil {
+		return errwrap.Wrapf("could not parse duration: {{err}}", err)
+	}
+	if duration <= 0 || duration > gcsV4MaxExpiration {
+		return fmt.Errorf("duration must be positive and at most %s for signing_version v4, got %s", gcsV4MaxExpiration, duration)
+	}
+
+	urlData := &V4UrlData{
+		HttpMethod:  d.Get("http_method").(string),
+		ContentMd5:  d.Get("content_md5").(string),
+		ContentType: d.Get("content_type").(string),
+		Path:        fmt.Sprintf("/%s/%s", d.Get("bucket").(string), d.Get("path").(string)),
+		Timestamp:   time.Now().UTC(),
+		Expires:     duration,
+	}
+
+	if v, ok := d.GetOk("extension_headers"); ok {
+		urlData.HttpHeaders = convertStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_parameters"); ok {
+		urlData.QueryParameters = convertStringMap(v.(map[string]interface{}))
+	}
+
+	jwtConfig, err := loadJwtConfig(d, config)
+	if err != nil {
+		return err
+	}
+	urlData.JwtConfig = jwtConfig
+
+	signature, err := urlData.Signature()
+	if err != nil {
+		return err
+	}
+
+	signedUrl, err := urlData.SignedUrl()
+	if err != nil {
+		return err
+	}
+
+	d.Set("signed_url", signedUrl)
+	d.SetId(signature)
+
+	return nil
+}
+
 // loadJwtConfig looks for credentials json in the following places,
 // in order of preference:
 //   1. `credentials` attribute of the datasource
@@ -358,3 +433,178 @@ func SignString(toSign []byte, cfg *jwt.Config) ([]byte, error) {
 
 	return signed, nil
 }
+
+// V4UrlData stores the values required to create a V4 Signed Url
+// see https://cloud.google.com/storage/docs/access-control/signing-urls-manually
+type V4UrlData struct {
+	JwtConfig       *jwt.Config
+	ContentMd5      string
+	ContentType     string
+	HttpMethod      string
+	HttpHeaders     map[string]string
+	QueryParameters map[string]string
+	Path            string
+	Timestamp       time.Time
+	Expires         time.Duration
+}
+
+// CredentialScope returns the scope the signature is valid for, e.g.
+// 20190201/auto/storage/goog4_request
+func (u *V4UrlData) CredentialScope() string {
+	return fmt.Sprintf("%s/auto/storage/goog4_request", u.Timestamp.UTC().Format("20060102"))
+}
+
+// headers returns the lowercased headers the signature covers, including host.
+func (u *V4UrlData) headers() map[string]string {
+	headers := map[string]string{"host": gcsHost}
+	if u.ContentMd5 != "" {
+		headers["content-md5"] = u.ContentMd5
+	}
+	if u.ContentType != "" {
+		headers["content-type"] = u.ContentType
+	}
+	for k, v := range u.HttpHeaders {
+		headers[strings.ToLower(k)] = strings.TrimSpace(v)
+	}
+
+	return headers
+}
+
+func (u *V4UrlData) signedHeaders() string {
+	var keys []string
+	for k := range u.headers() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return strings.Join(keys, ";")
+}
+
+// CanonicalQueryString returns the sorted, encoded query string of the url,
+// without the signature.
+func (u *V4UrlData) CanonicalQueryString() string {
+	params := map[string]string{
+		"X-Goog-Algorithm":     gcsV4SigningAlgorithm,
+		"X-Goog-Credential":    fmt.Sprintf("%s/%s", u.JwtConfig.Email, u.CredentialScope()),
+		"X-Goog-Date":          u.Timestamp.UTC().Format("20060102T150405Z"),
+		"X-Goog-Expires":       strconv.Itoa(int(u.Expires.Seconds())),
+		"X-Goog-SignedHeaders": u.signedHeaders(),
+	}
+	for k, v := range u.QueryParameters {
+		params[k] = v
+	}
+
+	var keys []string
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", gcsV4Escape(k, false), gcsV4Escape(params[k], false)))
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// CanonicalRequest returns the canonical form of the request being signed.
+// Example output:
+// -------------------
+// GET
+// /bucket/objectname
+// X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=...
+// host:storage.googleapis.com
+//
+// host
+// UNSIGNED-PAYLOAD
+// -------------------
+func (u *V4UrlData) CanonicalRequest() string {
+	headers := u.headers()
+	var keys []string
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString(u.HttpMethod)
+	buf.WriteString("\n")
+	buf.WriteString(gcsV4Escape(u.Path, true))
+	buf.WriteString("\n")
+	buf.WriteString(u.CanonicalQueryString())
+	buf.WriteString("\n")
+	for _, k := range keys {
+		buf.WriteString(fmt.Sprintf("%s:%s\n", k, headers[k]))
+	}
+	buf.WriteString("\n")
+	buf.WriteString(strings.Join(keys, ";"))
+	buf.WriteString("\n")
+	buf.WriteString("UNSIGNED-PAYLOAD")
+
+	return buf.String()
+}
+
+// SigningString returns the string that is signed with the service account key.
+func (u *V4UrlData) SigningString() []byte {
+	hash := sha256.Sum256([]byte(u.CanonicalRequest()))
+
+	var buf bytes.Buffer
+	buf.WriteString(gcsV4SigningAlgorithm)
+	buf.WriteString("\n")
+	buf.WriteString(u.Timestamp.UTC().Format("20060102T150405Z"))
+	buf.WriteString("\n")
+	buf.WriteString(u.CredentialScope())
+	buf.WriteString("\n")
+	buf.WriteString(hex.EncodeToString(hash[:]))
+
+	return buf.Bytes()
+}
+
+// Signature returns the hex encoded signature of the SigningString()
+func (u *V4UrlData) Signature() (string, error) {
+	signature, err := SignString(u.SigningString(), u.JwtConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(signature), nil
+}
+
+// SignedUrl constructs the final V4 signed URL
+func (u *V4UrlData) SignedUrl() (string, error) {
+	signature, err := u.Signature()
+	if err != nil {
+		return "", err
+	}
+
+	var urlBuffer bytes.Buffer
+	urlBuffer.WriteString(gcsBaseUrl)
+	urlBuffer.WriteString(gcsV4Escape(u.Path, true))
+	urlBuffer.WriteString("?")
+	urlBuffer.WriteString(u.CanonicalQueryString())
+	urlBuffer.WriteString("&X-Goog-Signature=")
+	urlBuffer.WriteString(signature)
+
+	return urlBuffer.String(), nil
+}
+
+// gcsV4Escape percent-encodes every byte of s except the RFC 3986 unreserved
+// characters, and optionally '/'.
+func gcsV4Escape(s string, keepSlash bool) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			buf.WriteByte(c)
+		case c == '/' && keepSlash:
+			buf.WriteByte(c)
+		default:
+			buf.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+
+	return buf.String()
+}
@@ -4,17 +4,24 @@ import (
 	"testing"
 
 	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/jwt"
 )
 
 const fakeCredentials = `{
@@ -99,6 +106,148 @@ func TestUrlData_SignedUrl(t *testing.T) {
 	}
 }
 
+// testV4Timestamp is the fixed signing time used by the V4 unit tests, so
+// their output is deterministic.
+var testV4Timestamp = time.Date(2019, time.February, 1, 9, 0, 0, 0, time.UTC)
+
+func testLoadFakeJwtConfig(t *testing.T) *jwt.Config {
+	contents, err := ioutil.ReadFile(testFakeCredentialsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := google.JWTConfigFromJSON(contents, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cfg
+}
+
+// testVerifySignature checks that signature is a valid hex encoded
+// RSA-SHA256 signature of toSign made with the key in cfg.
+func testVerifySignature(t *testing.T, cfg *jwt.Config, toSign []byte, signature string) {
+	pk, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		t.Fatalf("Signature %q is not hex encoded: %s", signature, err)
+	}
+
+	hashed := sha256.Sum256(toSign)
+	if err := rsa.VerifyPKCS1v15(&pk.PublicKey, crypto.SHA256, hashed[:], sig); err != nil {
+		t.Errorf("Signature does not verify: %s", err)
+	}
+}
+
+func TestV4UrlData_CanonicalRequest(t *testing.T) {
+	cfg := testLoadFakeJwtConfig(t)
+
+	cases := map[string]struct {
+		UrlData  *V4UrlData
+		Expected string
+	}{
+		"basic": {
+			UrlData: &V4UrlData{
+				HttpMethod: "GET",
+				Path:       "/test-bucket/path/to/file",
+			},
+			Expected: strings.Join([]string{
+				"GET",
+				"/test-bucket/path/to/file",
+				"X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=foo%40bar.com%2F20190201%2Fauto%2Fstorage%2Fgoog4_request&X-Goog-Date=20190201T090000Z&X-Goog-Expires=3600&X-Goog-SignedHeaders=host",
+				"host:storage.googleapis.com",
+				"",
+				"host",
+				"UNSIGNED-PAYLOAD",
+			}, "\n"),
+		},
+		"headers and query parameters": {
+			UrlData: &V4UrlData{
+				HttpMethod:  "PUT",
+				Path:        "/test-bucket/my file.txt",
+				ContentType: "text/plain",
+				HttpHeaders: map[string]string{
+					"X-Goog-Meta-Owner": " team ",
+				},
+				QueryParameters: map[string]string{
+					"generation": "1",
+					"a b":        "c/d",
+				},
+			},
+			Expected: strings.Join([]string{
+				"PUT",
+				"/test-bucket/my%20file.txt",
+				"X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=foo%40bar.com%2F20190201%2Fauto%2Fstorage%2Fgoog4_request&X-Goog-Date=20190201T090000Z&X-Goog-Expires=3600&X-Goog-SignedHeaders=content-type%3Bhost%3Bx-goog-meta-owner&a%20b=c%2Fd&generation=1",
+				"content-type:text/plain",
+				"host:storage.googleapis.com",
+				"x-goog-meta-owner:team",
+				"",
+				"content-type;host;x-goog-meta-owner",
+				"UNSIGNED-PAYLOAD",
+			}, "\n"),
+		},
+	}
+
+	for tn, tc := range cases {
+		tc.UrlData.JwtConfig = cfg
+		tc.UrlData.Timestamp = testV4Timestamp
+		tc.UrlData.Expires = time.Hour
+
+		if got := tc.UrlData.CanonicalRequest(); got != tc.Expected {
+			t.Errorf("bad: %s, canonical request does not match:\n%s\n\nexpected:\n%s", tn, got, tc.Expected)
+		}
+	}
+}
+
+func TestV4UrlData_SignedUrl(t *testing.T) {
+	cfg := testLoadFakeJwtConfig(t)
+
+	urlData := &V4UrlData{
+		HttpMethod: "GET",
+		Path:       "/test-bucket/path/to/file",
+		JwtConfig:  cfg,
+		Timestamp:  testV4Timestamp,
+		Expires:    time.Hour,
+	}
+
+	hash := sha256.Sum256([]byte(urlData.CanonicalRequest()))
+	expectedSigningString := "GOOG4-RSA-SHA256\n20190201T090000Z\n20190201/auto/storage/goog4_request\n" + hex.EncodeToString(hash[:])
+	if got := string(urlData.SigningString()); got != expectedSigningString {
+		t.Errorf("String to sign does not match:\n%s\n%s", expectedSigningString, got)
+	}
+
+	signature, err := urlData.Signature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	testVerifySignature(t, cfg, urlData.SigningString(), signature)
+
+	result, err := urlData.SignedUrl()
+	if err != nil {
+		t.Fatalf("Could not generate signed url: %+v", err)
+	}
+
+	expected := "https://storage.googleapis.com/test-bucket/path/to/file?" +
+		"X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=foo%40bar.com%2F20190201%2Fauto%2Fstorage%2Fgoog4_request&X-Goog-Date=20190201T090000Z&X-Goog-Expires=3600&X-Goog-SignedHeaders=host" +
+		"&X-Goog-Signature=" + signature
+	if result != expected {
+		t.Errorf("URL does not match expected value:\n%s\n%s", expected, result)
+	}
+
+	// Signing is deterministic, so the same inputs always give the same URL.
+	again, err := urlData.SignedUrl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != result {
+		t.Errorf("Signing the same URL twice gave different results:\n%s\n%s", result, again)
+	}
+}
+
 func TestAccStorageSignedUrl_basic(t *testing.T) {
 	t.Parallel()
 
@@ -137,6 +286,8 @@ func TestAccStorageSignedUrl_accTest(t *testing.T) {
 					testAccGoogleSignedUrlRetrieval("data.google_storage_object_signed_url.story_url_w_headers", headers),
 					testAccGoogleSignedUrlRetrieval("data.google_storage_object_signed_url.story_url_w_content_type", nil),
 					testAccGoogleSignedUrlRetrieval("data.google_storage_object_signed_url.story_url_w_md5", nil),
+					testAccGoogleSignedUrlRetrieval("data.google_storage_object_signed_url.story_url_v4", nil),
+					testAccGoogleSignedUrlRetrieval("data.google_storage_object_signed_url.story_url_v4_w_headers", headers),
 				),
 			},
 		},
@@ -263,5 +414,21 @@ data "google_storage_object_signed_url" "story_url_w_md5" {
   path   = "${google_storage_bucket_object.story.name}"
 
   content_md5 = "${google_storage_bucket_object.story.md5hash}"
+}
+
+data "google_storage_object_signed_url" "story_url_v4" {
+  bucket          = "${google_storage_bucket.bucket.name}"
+  path            = "${google_storage_bucket_object.story.name}"
+  signing_version = "v4"
+}
+
+data "google_storage_object_signed_url" "story_url_v4_w_headers" {
+  bucket          = "${google_storage_bucket.bucket.name}"
+  path            = "${google_storage_bucket_object.story.name}"
+  signing_version = "v4"
+  extension_headers {
+  	x-goog-test = "foo"
+  	x-goog-if-generation-match = 1
+  }
 }`, bucketName)
 }
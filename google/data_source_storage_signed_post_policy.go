@@ -0,0 +1,242 @@
+package google
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/oauth2/jwt"
+)
+
+func dataSourceGoogleSignedPostPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleSignedPostPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"key_prefix"},
+			},
+			"key_prefix": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"key"},
+			},
+			"content_length_range": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"max": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"credentials": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"duration": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1h",
+			},
+			"success_action_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp(`^(200|201|204)$`),
+			},
+			"fields": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleSignedPostPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	duration, err := time.ParseDuration(d.Get("duration").(string))
+	if err != nil {
+		return errwrap.Wrapf("could not parse duration: {{err}}", err)
+	}
+	if duration <= 0 || duration > gcsV4MaxExpiration {
+		return fmt.Errorf("duration must be positive and at most %s, got %s", gcsV4MaxExpiration, duration)
+	}
+
+	_, hasKey := d.GetOk("key")
+	_, hasPrefix := d.GetOk("key_prefix")
+	if !hasKey && !hasPrefix {
+		return fmt.Errorf("one of key or key_prefix must be set")
+	}
+
+	policyData := &PostPolicyData{
+		Bucket:              d.Get("bucket").(string),
+		Key:                 d.Get("key").(string),
+		KeyPrefix:           d.Get("key_prefix").(string),
+		ContentType:         d.Get("content_type").(string),
+		SuccessActionStatus: d.Get("success_action_status").(string),
+		Timestamp:           time.Now().UTC(),
+		Expires:             duration,
+	}
+
+	if v, ok := d.GetOk("content_length_range"); ok {
+		lengthRange := v.([]interface{})[0].(map[string]interface{})
+		policyData.ContentLengthMin = lengthRange["min"].(int)
+		policyData.ContentLengthMax = lengthRange["max"].(int)
+		if policyData.ContentLengthMin > policyData.ContentLengthMax {
+			return fmt.Errorf("content_length_range min (%d) must not be greater than max (%d)",
+				policyData.ContentLengthMin, policyData.ContentLengthMax)
+		}
+	}
+
+	jwtConfig, err := loadJwtConfig(d, config)
+	if err != nil {
+		return err
+	}
+	policyData.JwtConfig = jwtConfig
+
+	fields, err := policyData.Fields()
+	if err != nil {
+		return err
+	}
+
+	d.Set("url", fmt.Sprintf("%s/%s", gcsBaseUrl, policyData.Bucket))
+	d.Set("fields", fields)
+	d.SetId(fields["x-goog-signature"])
+
+	return nil
+}
+
+// PostPolicyData stores the values required to create a signed V4 POST policy
+// for HTML form uploads.
+// see https://cloud.google.com/storage/docs/xml-api/post-object
+type PostPolicyData struct {
+	JwtConfig           *jwt.Config
+	Bucket              string
+	Key                 string
+	KeyPrefix           string
+	ContentType         string
+	ContentLengthMin    int
+	ContentLengthMax    int
+	SuccessActionStatus string
+	Timestamp           time.Time
+	Expires             time.Duration
+}
+
+func (p *PostPolicyData) credential() string {
+	return fmt.Sprintf("%s/%s/auto/storage/goog4_request", p.JwtConfig.Email, p.Timestamp.UTC().Format("20060102"))
+}
+
+// formKey returns the value of the key form field. When only a prefix is
+// given, GCS substitutes ${filename} with the name of the uploaded file.
+func (p *PostPolicyData) formKey() string {
+	if p.Key != "" {
+		return p.Key
+	}
+
+	return p.KeyPrefix + "${filename}"
+}
+
+// Policy returns the JSON policy document, listing the conditions the upload
+// form has to satisfy.
+func (p *PostPolicyData) Policy() ([]byte, error) {
+	conditions := []interface{}{
+		map[string]string{"bucket": p.Bucket},
+	}
+
+	if p.Key != "" {
+		conditions = append(conditions, map[string]string{"key": p.Key})
+	} else {
+		conditions = append(conditions, []string{"starts-with", "$key", p.KeyPrefix})
+	}
+
+	if p.ContentType != "" {
+		conditions = append(conditions, map[string]string{"content-type": p.ContentType})
+	}
+	if p.ContentLengthMax > 0 {
+		conditions = append(conditions, []interface{}{"content-length-range", p.ContentLengthMin, p.ContentLengthMax})
+	}
+	if p.SuccessActionStatus != "" {
+		conditions = append(conditions, map[string]string{"success_action_status": p.SuccessActionStatus})
+	}
+
+	conditions = append(conditions,
+		map[string]string{"x-goog-algorithm": gcsV4SigningAlgorithm},
+		map[string]string{"x-goog-credential": p.credential()},
+		map[string]string{"x-goog-date": p.Timestamp.UTC().Format("20060102T150405Z")},
+	)
+
+	return json.Marshal(map[string]interface{}{
+		"conditions": conditions,
+		"expiration": p.Timestamp.Add(p.Expires).UTC().Format(time.RFC3339),
+	})
+}
+
+// EncodedPolicy returns the base64 encoded Policy(), which is also the string
+// that gets signed.
+func (p *PostPolicyData) EncodedPolicy() (string, error) {
+	policy, err := p.Policy()
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(policy), nil
+}
+
+// Fields returns the form fields a browser has to send alongside the file.
+func (p *PostPolicyData) Fields() (map[string]string, error) {
+	policy, err := p.EncodedPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := SignString([]byte(policy), p.JwtConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		"key":               p.formKey(),
+		"policy":            policy,
+		"x-goog-algorithm":  gcsV4SigningAlgorithm,
+		"x-goog-credential": p.credential(),
+		"x-goog-date":       p.Timestamp.UTC().Format("20060102T150405Z"),
+		"x-goog-signature":  hex.EncodeToString(signature),
+	}
+
+	if p.ContentType != "" {
+		fields["content-type"] = p.ContentType
+	}
+	if p.SuccessActionStatus != "" {
+		fields["success_action_status"] = p.SuccessActionStatus
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,179 @@
+package google
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestPostPolicyData_Policy(t *testing.T) {
+	cfg := testLoadFakeJwtConfig(t)
+
+	cases := map[string]struct {
+		PolicyData *PostPolicyData
+		Expected   string
+		Key        string
+	}{
+		"key": {
+			PolicyData: &PostPolicyData{
+				Bucket: "test-bucket",
+				Key:    "uploads/file.txt",
+			},
+			Expected: `{"conditions":[{"bucket":"test-bucket"},{"key":"uploads/file.txt"},` +
+				`{"x-goog-algorithm":"GOOG4-RSA-SHA256"},{"x-goog-credential":"foo@bar.com/20190201/auto/storage/goog4_request"},` +
+				`{"x-goog-date":"20190201T090000Z"}],"expiration":"2019-02-01T10:00:00Z"}`,
+			Key: "uploads/file.txt",
+		},
+		"key prefix with conditions": {
+			PolicyData: &PostPolicyData{
+				Bucket:              "test-bucket",
+				KeyPrefix:           "uploads/",
+				ContentType:         "image/png",
+				ContentLengthMin:    1,
+				ContentLengthMax:    1048576,
+				SuccessActionStatus: "201",
+			},
+			Expected: `{"conditions":[{"bucket":"test-bucket"},["starts-with","$key","uploads/"],` +
+				`{"content-type":"image/png"},["content-length-range",1,1048576],{"success_action_status":"201"},` +
+				`{"x-goog-algorithm":"GOOG4-RSA-SHA256"},{"x-goog-credential":"foo@bar.com/20190201/auto/storage/goog4_request"},` +
+				`{"x-goog-date":"20190201T090000Z"}],"expiration":"2019-02-01T10:00:00Z"}`,
+			Key: "uploads/${filename}",
+		},
+	}
+
+	for tn, tc := range cases {
+		tc.PolicyData.JwtConfig = cfg
+		tc.PolicyData.Timestamp = testV4Timestamp
+		tc.PolicyData.Expires = time.Hour
+
+		policy, err := tc.PolicyData.Policy()
+		if err != nil {
+			t.Fatalf("bad: %s, %s", tn, err)
+		}
+		if string(policy) != tc.Expected {
+			t.Errorf("bad: %s, policy does not match:\n%s\n%s", tn, policy, tc.Expected)
+		}
+
+		fields, err := tc.PolicyData.Fields()
+		if err != nil {
+			t.Fatalf("bad: %s, %s", tn, err)
+		}
+
+		if fields["key"] != tc.Key {
+			t.Errorf("bad: %s, expected key field %q, got %q", tn, tc.Key, fields["key"])
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(fields["policy"])
+		if err != nil {
+			t.Fatalf("bad: %s, policy field is not base64 encoded: %s", tn, err)
+		}
+		if string(decoded) != tc.Expected {
+			t.Errorf("bad: %s, encoded policy does not match:\n%s\n%s", tn, decoded, tc.Expected)
+		}
+
+		testVerifySignature(t, cfg, []byte(fields["policy"]), fields["x-goog-signature"])
+	}
+}
+
+func TestPostPolicyData_Fields(t *testing.T) {
+	cfg := testLoadFakeJwtConfig(t)
+
+	policyData := &PostPolicyData{
+		JwtConfig:           cfg,
+		Bucket:              "test-bucket",
+		Key:                 "file.txt",
+		ContentType:         "text/plain",
+		SuccessActionStatus: "204",
+		Timestamp:           testV4Timestamp,
+		Expires:             time.Hour,
+	}
+
+	fields, err := policyData.Fields()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := policyData.EncodedPolicy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"key":                   "file.txt",
+		"policy":                policy,
+		"content-type":          "text/plain",
+		"success_action_status": "204",
+		"x-goog-algorithm":      "GOOG4-RSA-SHA256",
+		"x-goog-credential":     "foo@bar.com/20190201/auto/storage/goog4_request",
+		"x-goog-date":           "20190201T090000Z",
+		"x-goog-signature":      fields["x-goog-signature"],
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Fields do not match:\n%#v\n%#v", fields, expected)
+	}
+
+	again, err := policyData.Fields()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again["x-goog-signature"] != fields["x-goog-signature"] {
+		t.Errorf("Signing the same policy twice gave different signatures")
+	}
+}
+
+func TestAccStorageSignedPostPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleSignedPostPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccGoogleSignedPostPolicyExists("data.google_storage_signed_post_policy.upload"),
+					resource.TestCheckResourceAttr(
+						"data.google_storage_signed_post_policy.upload", "url", "https://storage.googleapis.com/friedchicken"),
+					resource.TestCheckResourceAttr(
+						"data.google_storage_signed_post_policy.upload", "fields.key", "uploads/${filename}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGoogleSignedPostPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r := s.RootModule().Resources[n]
+		if r == nil {
+			return fmt.Errorf("Datasource not found")
+		}
+		a := r.Primary.Attributes
+
+		for _, field := range []string{"policy", "x-goog-credential", "x-goog-signature"} {
+			if a["fields."+field] == "" {
+				return fmt.Errorf("field %s is empty: %v", field, a)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testGoogleSignedPostPolicyConfig = `
+data "google_storage_signed_post_policy" "upload" {
+  bucket       = "friedchicken"
+  key_prefix   = "uploads/"
+  content_type = "text/plain"
+
+  content_length_range {
+    min = 0
+    max = 1024
+  }
+}
+`
@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 const (
@@ -11,6 +12,11 @@ const (
 	zonalLinkTemplate           = "projects/%s/zones/%s/%s/%s"
 	zonalLinkBasePattern        = "projects/(.+)/zones/(.+)/%s/(.+)"
 	zonalPartialLinkBasePattern = "zones/(.+)/%s/(.+)"
+	regionalLinkTemplate        = "projects/%s/regions/%s/%s/%s"
+	regionalLinkBasePattern     = "projects/(.+)/regions/(.+)/%s/(.+)"
+	regionalPartialPattern      = "regions/(.+)/%s/(.+)"
+	rootLinkTemplate            = "%s/%s"
+	rootLinkBasePattern         = "(?:^|/)%s/([^/]+)$"
 )
 
 // ------------------------------------------------------------
@@ -29,6 +35,38 @@ func ParseDiskFieldValue(disk string, d TerraformResourceData, config *Config) (
 	return parseZonalFieldValue("disks", disk, "project", "zone", d, config, false)
 }
 
+func ParseSubnetworkFieldValue(subnetwork string, d TerraformResourceData, config *Config) (*RegionalFieldValue, error) {
+	return parseRegionalFieldValue("subnetworks", subnetwork, "project", "region", "zone", d, config, true)
+}
+
+func ParseSubnetworkFieldValueWithProjectField(subnetwork, projectSchemaField string, d TerraformResourceData, config *Config) (*RegionalFieldValue, error) {
+	return parseRegionalFieldValue("subnetworks", subnetwork, projectSchemaField, "region", "zone", d, config, true)
+}
+
+func ParseRegionBackendServiceFieldValue(backendService string, d TerraformResourceData, config *Config) (*RegionalFieldValue, error) {
+	return parseRegionalFieldValue("backendServices", backendService, "project", "region", "", d, config, true)
+}
+
+func ParseVpnGatewayFieldValue(vpnGateway string, d TerraformResourceData, config *Config) (*RegionalFieldValue, error) {
+	return parseRegionalFieldValue("targetVpnGateways", vpnGateway, "project", "region", "", d, config, false)
+}
+
+func ParseRouterFieldValue(router string, d TerraformResourceData, config *Config) (*RegionalFieldValue, error) {
+	return parseRegionalFieldValue("routers", router, "project", "region", "", d, config, true)
+}
+
+func ParseOrganizationFieldValue(organization string) (*RootFieldValue, error) {
+	return parseRootFieldValue("organizations", organization)
+}
+
+func ParseFolderFieldValue(folder string) (*RootFieldValue, error) {
+	return parseRootFieldValue("folders", folder)
+}
+
+func ParseBillingAccountFieldValue(billingAccount string) (*RootFieldValue, error) {
+	return parseRootFieldValue("billingAccounts", billingAccount)
+}
+
 // ------------------------------------------------------------
 // Base helpers used to create helpers for specific fields.
 // ------------------------------------------------------------
@@ -162,6 +200,121 @@ func parseZonalFieldValue(resourceType, fieldValue, projectSchemaField, zoneSche
 	}, nil
 }
 
+type RegionalFieldValue struct {
+	Project string
+	Region  string
+	Name    string
+
+	resourceType string
+}
+
+func (f RegionalFieldValue) RelativeLink() string {
+	if len(f.Name) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(regionalLinkTemplate, f.Project, f.Region, f.resourceType, f.Name)
+}
+
+// Parses a regional field supporting 5 different formats:
+// - https://www.googleapis.com/compute/ANY_VERSION/projects/{my_project}/regions/{region}/{resource_type}/{resource_name}
+// - projects/{my_project}/regions/{region}/{resource_type}/{resource_name}
+// - regions/{region}/{resource_type}/{resource_name}
+// - resource_name
+// - "" (empty string). RelativeLink() returns empty if isEmptyValid is true.
+//
+// If the project is not specified, it first tries to get the project from the `projectSchemaField` and then fallback on the default project.
+// If the region is not specified, it takes the value of `regionSchemaField`, then the region of the zone in `zoneSchemaField`
+// and then fallback on the default region.
+func parseRegionalFieldValue(resourceType, fieldValue, projectSchemaField, regionSchemaField, zoneSchemaField string, d TerraformResourceData, config *Config, isEmptyValid bool) (*RegionalFieldValue, error) {
+	if len(fieldValue) == 0 {
+		if isEmptyValid {
+			return &RegionalFieldValue{resourceType: resourceType}, nil
+		}
+		return nil, fmt.Errorf("The regional field for resource %s cannot be empty.", resourceType)
+	}
+
+	r := regexp.MustCompile(fmt.Sprintf(regionalLinkBasePattern, resourceType))
+	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
+		return &RegionalFieldValue{
+			Project:      parts[1],
+			Region:       parts[2],
+			Name:         parts[3],
+			resourceType: resourceType,
+		}, nil
+	}
+
+	project, err := getProjectFromSchema(projectSchemaField, d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	r = regexp.MustCompile(fmt.Sprintf(regionalPartialPattern, resourceType))
+	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
+		return &RegionalFieldValue{
+			Project:      project,
+			Region:       parts[1],
+			Name:         parts[2],
+			resourceType: resourceType,
+		}, nil
+	}
+
+	region, err := getRegionFromSchema(regionSchemaField, zoneSchemaField, d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegionalFieldValue{
+		Project:      project,
+		Region:       region,
+		Name:         GetResourceNameFromSelfLink(fieldValue),
+		resourceType: resourceType,
+	}, nil
+}
+
+// RootFieldValue references a resource that lives outside of any project, such as an
+// organization, a folder or a billing account.
+type RootFieldValue struct {
+	Id string
+
+	resourceType string
+}
+
+func (f RootFieldValue) RelativeLink() string {
+	if len(f.Id) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(rootLinkTemplate, f.resourceType, f.Id)
+}
+
+// Parses a field referencing a root resource supporting 3 different formats:
+// - https://{service}.googleapis.com/ANY_VERSION/{resource_type}/{id}
+// - {resource_type}/{id}
+// - id
+func parseRootFieldValue(resourceType, fieldValue string) (*RootFieldValue, error) {
+	if len(fieldValue) == 0 {
+		return nil, fmt.Errorf("The field for resource %s cannot be empty.", resourceType)
+	}
+
+	r := regexp.MustCompile(fmt.Sprintf(rootLinkBasePattern, resourceType))
+	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
+		return &RootFieldValue{
+			Id:           parts[1],
+			resourceType: resourceType,
+		}, nil
+	}
+
+	if strings.Contains(fieldValue, "/") {
+		return nil, fmt.Errorf("Invalid field format. Got '%s', expected format '%s'", fieldValue, fmt.Sprintf(rootLinkTemplate, resourceType, "{id}"))
+	}
+
+	return &RootFieldValue{
+		Id:           fieldValue,
+		resourceType: resourceType,
+	}, nil
+}
+
 func getProjectFromSchema(projectSchemaField string, d TerraformResourceData, config *Config) (string, error) {
 	res, ok := d.GetOk(projectSchemaField)
 	if !ok || len(projectSchemaField) == 0 {
@@ -172,3 +325,20 @@ func getProjectFromSchema(projectSchemaField string, d TerraformResourceData, co
 	}
 	return res.(string), nil
 }
+
+func getRegionFromSchema(regionSchemaField, zoneSchemaField string, d TerraformResourceData, config *Config) (string, error) {
+	if len(regionSchemaField) > 0 {
+		if res, ok := d.GetOk(regionSchemaField); ok {
+			return res.(string), nil
+		}
+	}
+	if len(zoneSchemaField) > 0 {
+		if res, ok := d.GetOk(zoneSchemaField); ok {
+			return getRegionFromZone(res.(string)), nil
+		}
+	}
+	if config.Region != "" {
+		return config.Region, nil
+	}
+	return "", fmt.Errorf("region: required field is not set")
+}
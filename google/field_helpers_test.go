@@ -184,3 +184,186 @@ func TestParseZonalFieldValue(t *testing.T) {
 		}
 	}
 }
+
+func TestParseRegionalFieldValue(t *testing.T) {
+	const resourceType = "subnetworks"
+	cases := map[string]struct {
+		FieldValue           string
+		ExpectedRelativeLink string
+		ExpectedError        bool
+		IsEmptyValid         bool
+		ProjectSchemaField   string
+		ProjectSchemaValue   string
+		RegionSchemaField    string
+		RegionSchemaValue    string
+		ZoneSchemaField      string
+		ZoneSchemaValue      string
+		Config               *Config
+	}{
+		"subnetwork is a full self link": {
+			FieldValue:           "https://www.googleapis.com/compute/v1/projects/myproject/regions/us-central1/subnetworks/my-subnetwork",
+			ExpectedRelativeLink: "projects/myproject/regions/us-central1/subnetworks/my-subnetwork",
+		},
+		"subnetwork is a relative self link": {
+			FieldValue:           "projects/myproject/regions/us-central1/subnetworks/my-subnetwork",
+			ExpectedRelativeLink: "projects/myproject/regions/us-central1/subnetworks/my-subnetwork",
+		},
+		"subnetwork is a partial relative self link": {
+			FieldValue:           "regions/us-central1/subnetworks/my-subnetwork",
+			Config:               &Config{Project: "default-project"},
+			ExpectedRelativeLink: "projects/default-project/regions/us-central1/subnetworks/my-subnetwork",
+		},
+		"subnetwork is the name only": {
+			FieldValue:           "my-subnetwork",
+			RegionSchemaField:    "region",
+			RegionSchemaValue:    "us-east1",
+			Config:               &Config{Project: "default-project"},
+			ExpectedRelativeLink: "projects/default-project/regions/us-east1/subnetworks/my-subnetwork",
+		},
+		"subnetwork is the name only and has a project set in schema": {
+			FieldValue:           "my-subnetwork",
+			ProjectSchemaField:   "project",
+			ProjectSchemaValue:   "schema-project",
+			RegionSchemaField:    "region",
+			RegionSchemaValue:    "us-east1",
+			Config:               &Config{Project: "default-project"},
+			ExpectedRelativeLink: "projects/schema-project/regions/us-east1/subnetworks/my-subnetwork",
+		},
+		"subnetwork is the name only and the region is derived from the zone": {
+			FieldValue:           "my-subnetwork",
+			RegionSchemaField:    "region",
+			ZoneSchemaField:      "zone",
+			ZoneSchemaValue:      "us-east1-b",
+			Config:               &Config{Project: "default-project"},
+			ExpectedRelativeLink: "projects/default-project/regions/us-east1/subnetworks/my-subnetwork",
+		},
+		"subnetwork is the name only and the region is taken from the provider": {
+			FieldValue:           "my-subnetwork",
+			RegionSchemaField:    "region",
+			Config:               &Config{Project: "default-project", Region: "europe-west1"},
+			ExpectedRelativeLink: "projects/default-project/regions/europe-west1/subnetworks/my-subnetwork",
+		},
+		"subnetwork is the name only and no region can be found": {
+			FieldValue:        "my-subnetwork",
+			RegionSchemaField: "region",
+			Config:            &Config{Project: "default-project"},
+			ExpectedError:     true,
+		},
+		"subnetwork is empty and it is valid": {
+			FieldValue:           "",
+			IsEmptyValid:         true,
+			ExpectedRelativeLink: "",
+		},
+		"subnetwork is empty and it is not valid": {
+			FieldValue:    "",
+			IsEmptyValid:  false,
+			ExpectedError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		fieldsInSchema := make(map[string]interface{})
+
+		if len(tc.ProjectSchemaValue) > 0 && len(tc.ProjectSchemaField) > 0 {
+			fieldsInSchema[tc.ProjectSchemaField] = tc.ProjectSchemaValue
+		}
+
+		if len(tc.RegionSchemaValue) > 0 && len(tc.RegionSchemaField) > 0 {
+			fieldsInSchema[tc.RegionSchemaField] = tc.RegionSchemaValue
+		}
+
+		if len(tc.ZoneSchemaValue) > 0 && len(tc.ZoneSchemaField) > 0 {
+			fieldsInSchema[tc.ZoneSchemaField] = tc.ZoneSchemaValue
+		}
+
+		d := &ResourceDataMock{
+			FieldsInSchema: fieldsInSchema,
+		}
+
+		v, err := parseRegionalFieldValue(resourceType, tc.FieldValue, tc.ProjectSchemaField, tc.RegionSchemaField, tc.ZoneSchemaField, d, tc.Config, tc.IsEmptyValid)
+
+		if err != nil {
+			if !tc.ExpectedError {
+				t.Errorf("bad: %s, did not expect an error. Error: %s", tn, err)
+			}
+		} else {
+			if tc.ExpectedError {
+				t.Errorf("bad: %s, expected an error", tn)
+			}
+			if v.RelativeLink() != tc.ExpectedRelativeLink {
+				t.Errorf("bad: %s, expected relative link to be '%s' but got '%s'", tn, tc.ExpectedRelativeLink, v.RelativeLink())
+			}
+		}
+	}
+}
+
+func TestParseRootFieldValue(t *testing.T) {
+	cases := map[string]struct {
+		ResourceType         string
+		FieldValue           string
+		ExpectedId           string
+		ExpectedRelativeLink string
+		ExpectedError        bool
+	}{
+		"organization is the id only": {
+			ResourceType:         "organizations",
+			FieldValue:           "123456789",
+			ExpectedId:           "123456789",
+			ExpectedRelativeLink: "organizations/123456789",
+		},
+		"organization is a relative link": {
+			ResourceType:         "organizations",
+			FieldValue:           "organizations/123456789",
+			ExpectedId:           "123456789",
+			ExpectedRelativeLink: "organizations/123456789",
+		},
+		"folder is a full self link": {
+			ResourceType:         "folders",
+			FieldValue:           "https://cloudresourcemanager.googleapis.com/v2beta1/folders/987654321",
+			ExpectedId:           "987654321",
+			ExpectedRelativeLink: "folders/987654321",
+		},
+		"folder is a relative link to another resource type": {
+			ResourceType:  "folders",
+			FieldValue:    "organizations/123456789",
+			ExpectedError: true,
+		},
+		"billing account is the id only": {
+			ResourceType:         "billingAccounts",
+			FieldValue:           "012345-567890-ABCDEF",
+			ExpectedId:           "012345-567890-ABCDEF",
+			ExpectedRelativeLink: "billingAccounts/012345-567890-ABCDEF",
+		},
+		"billing account is a full self link": {
+			ResourceType:         "billingAccounts",
+			FieldValue:           "https://cloudbilling.googleapis.com/v1/billingAccounts/012345-567890-ABCDEF",
+			ExpectedId:           "012345-567890-ABCDEF",
+			ExpectedRelativeLink: "billingAccounts/012345-567890-ABCDEF",
+		},
+		"billing account is empty": {
+			ResourceType:  "billingAccounts",
+			FieldValue:    "",
+			ExpectedError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		v, err := parseRootFieldValue(tc.ResourceType, tc.FieldValue)
+
+		if err != nil {
+			if !tc.ExpectedError {
+				t.Errorf("bad: %s, did not expect an error. Error: %s", tn, err)
+			}
+		} else {
+			if tc.ExpectedError {
+				t.Errorf("bad: %s, expected an error", tn)
+			}
+			if v.Id != tc.ExpectedId {
+				t.Errorf("bad: %s, expected id to be '%s' but got '%s'", tn, tc.ExpectedId, v.Id)
+			}
+			if v.RelativeLink() != tc.ExpectedRelativeLink {
+				t.Errorf("bad: %s, expected relative link to be '%s' but got '%s'", tn, tc.ExpectedRelativeLink, v.RelativeLink())
+			}
+		}
+	}
+}
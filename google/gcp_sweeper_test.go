@@ -1,11 +1,15 @@
 package google
 
 import (
+	"flag"
 	"fmt"
+	"log"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/compute/v1"
 )
 
 func TestMain(m *testing.M) {
@@ -33,3 +37,168 @@ func sharedConfigForRegion(region string) (*Config, error) {
 
 	return conf, nil
 }
+
+// sweepDryRun makes the sweepers only log the resources they would delete, e.g.
+//
+//	make sweep SWEEPARGS=-sweep-dry-run
+//
+// Sweepers are registered for the resource types that create standalone objects.
+// Resources that only exist as part of another one (IAM bindings, ACLs, node pools,
+// record sets, ...) go away with their parent, and KMS key rings can't be deleted.
+var sweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources the sweepers would delete without deleting them")
+
+// testResourcePrefixes are the prefixes of the names given to resources by the
+// acceptance tests. Sweepers only delete resources whose name starts with one of them.
+var testResourcePrefixes = []string{
+	"address-test-",
+	"autoscaler-test-",
+	"cluster-test-",
+	"container-net-",
+	"disk-test-",
+	"dnszone-test-",
+	"dproc-cluster-test-",
+	"firewall-test-",
+	"forwardrule-test-",
+	"gateway-test-",
+	"health-test-",
+	"healthcheck-test-",
+	"httpsproxy-test-",
+	"igm-test-",
+	"image-test-",
+	"inst-test-",
+	"instance-test-",
+	"instance-testd-",
+	"instance-testf-",
+	"instance-testi-",
+	"instance-tpl-",
+	"instancegroup-test-",
+	"instancet-test-",
+	"mzone-test-",
+	"network-test-",
+	"peering-test-",
+	"pstopic-test-",
+	"region-autoscaler-test-",
+	"route-test-",
+	"router-interface-test-",
+	"router-peer-test-",
+	"router-test-",
+	"runtimeconfig-test-",
+	"source-repo-repository-test-",
+	"span-itest-",
+	"spanner-test-",
+	"sql-instance-test-",
+	"sqldatabasetest",
+	"sslcert-test-",
+	"terrafom-test-",
+	"terraform-test-",
+	"test-image-",
+	"tf-cluster-",
+	"tf-lw-",
+	"tf-nodepool-",
+	"tf-test-",
+	"tf_test_",
+	"thttp-test-",
+	"tpool-test-",
+	"tssl-test-",
+	"ttcp-test-",
+	"tunnel-test-",
+	"urlmap-test-",
+	"variable-test-",
+}
+
+// isSweepableTestResource returns whether a resource name was generated by an acceptance test.
+func isSweepableTestResource(name string) bool {
+	for _, prefix := range testResourcePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sharedLoadedConfigForRegion returns a config setup for the sweeper functions
+// of a given region, with its clients instantiated.
+func sharedLoadedConfigForRegion(region string) (*Config, error) {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("error getting shared config for region: %s", err)
+	}
+
+	if err := config.loadAndValidate(); err != nil {
+		return nil, fmt.Errorf("error loading: %s", err)
+	}
+
+	return config, nil
+}
+
+// sweepResource deletes a resource left behind by an acceptance test. Resources whose
+// name doesn't match a test prefix are ignored.
+func sweepResource(kind, name string, deleteFunc func() error) {
+	if !isSweepableTestResource(name) {
+		return
+	}
+
+	deleteSweptResource(kind, name, deleteFunc)
+}
+
+// deleteSweptResource deletes a resource matched by a sweeper, or only logs it when the
+// sweepers run in dry-run mode. Deletion failures are logged rather than returned so that
+// a single resource still in use doesn't prevent the others from being swept.
+func deleteSweptResource(kind, name string, deleteFunc func() error) {
+	if *sweepDryRun {
+		log.Printf("[INFO] Would delete %s %q", kind, name)
+		return
+	}
+
+	log.Printf("[INFO] Deleting %s %q", kind, name)
+	if err := deleteFunc(); err != nil {
+		log.Printf("[ERROR] Error deleting %s %q: %s", kind, name, err)
+	}
+}
+
+// sweepComputeResource deletes a compute resource left behind by an acceptance test and
+// waits for the deletion to complete.
+func sweepComputeResource(config *Config, kind, name string, deleteFunc func() (*compute.Operation, error)) {
+	sweepResource(kind, name, func() error {
+		op, err := deleteFunc()
+		if err != nil {
+			return err
+		}
+
+		return computeOperationWait(config.clientCompute, op, config.Project, fmt.Sprintf("Deleting %s", kind))
+	})
+}
+
+// getSweeperZones returns the names of the zones of a region.
+func getSweeperZones(config *Config, region string) ([]string, error) {
+	r, err := config.clientCompute.Regions.Get(config.Project, region).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error reading region %s: %s", region, err)
+	}
+
+	zones := make([]string, 0, len(r.Zones))
+	for _, zone := range r.Zones {
+		zones = append(zones, GetResourceNameFromSelfLink(zone))
+	}
+
+	return zones, nil
+}
+
+func TestIsSweepableTestResource(t *testing.T) {
+	cases := map[string]bool{
+		"tf-test-abcdefghij":          true,
+		"instance-test-abcdefghij":    true,
+		"tf-lw-123456":                true,
+		"production-network":          false,
+		"default":                     false,
+		"my-tf-test-network":          false,
+		"sqldatabasetest123456789012": true,
+	}
+
+	for name, expected := range cases {
+		if actual := isSweepableTestResource(name); actual != expected {
+			t.Errorf("Expected isSweepableTestResource(%q) to be %t, got %t", name, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,564 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+// importableObject is an existing object found in a project, along with the
+// information needed to read it through its resource's Read function.
+type importableObject struct {
+	// Name of the object, used to derive the name of the generated resource block.
+	Name string
+	// ImportId is the identifier accepted by `terraform import` for the resource.
+	ImportId string
+	// Fields are set on the resource data before it is read, for resources that
+	// can't derive everything they need from the import id.
+	Fields map[string]interface{}
+}
+
+type importableResource struct {
+	Type string
+	List func(config *Config, project string) ([]importableObject, error)
+}
+
+// importableResources lists the resource types the import configuration
+// generator knows how to enumerate, in the order they are emitted.
+var importableResources = []importableResource{
+	{"google_compute_network", listComputeNetworksForImport},
+	{"google_compute_subnetwork", listComputeSubnetworksForImport},
+	{"google_compute_firewall", listComputeFirewallsForImport},
+	{"google_compute_instance", listComputeInstancesForImport},
+	{"google_compute_disk", listComputeDisksForImport},
+	{"google_storage_bucket", listStorageBucketsForImport},
+	{"google_pubsub_topic", listPubsubTopicsForImport},
+	{"google_sql_database_instance", listSqlDatabaseInstancesForImport},
+	{"google_container_cluster", listContainerClustersForImport},
+}
+
+// GenerateImportConfig enumerates the existing objects of the supported resource
+// types in the configured project. For each object, it writes a resource block
+// to hclOut and the matching `terraform import` command to importOut.
+//
+// Objects are read with their resource's own importer and Read function, and only
+// attributes that can be set in configuration are rendered. The output is meant
+// as a starting point and should be reviewed before it is applied.
+func GenerateImportConfig(config *Config, types []string, hclOut, importOut io.Writer) error {
+	if config.Project == "" {
+		return fmt.Errorf("project: required field is not set")
+	}
+
+	resources, err := selectImportableResources(types)
+	if err != nil {
+		return err
+	}
+
+	if err := config.loadAndValidate(); err != nil {
+		return err
+	}
+
+	provider := Provider().(*schema.Provider)
+	names := make(map[string]struct{})
+	for _, res := range resources {
+		objects, err := res.List(config, config.Project)
+		if err != nil {
+			return fmt.Errorf("Error listing %s: %s", res.Type, err)
+		}
+
+		r := provider.ResourcesMap[res.Type]
+		for _, obj := range objects {
+			d, err := readImportableObject(r, obj, config)
+			if err != nil {
+				return fmt.Errorf("Error reading %s %q: %s", res.Type, obj.ImportId, err)
+			}
+			if d == nil {
+				log.Printf("[WARN] %s %q disappeared while generating configuration, skipping", res.Type, obj.ImportId)
+				continue
+			}
+
+			name := uniqueHclResourceName(obj.Name, res.Type, names)
+			attributes := d.State().Attributes
+			values := make(map[string]interface{})
+			for k, s := range r.Schema {
+				if !isConfigurableSchema(s) || !hasStateAttribute(attributes, k) {
+					continue
+				}
+				values[k] = d.Get(k)
+			}
+
+			if _, err := io.WriteString(hclOut, renderHclResource(res.Type, name, r.Schema, values)+"\n"); err != nil {
+				return err
+			}
+
+			if _, err := io.WriteString(importOut, importCommand(res.Type, name, r, obj)+"\n"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func selectImportableResources(types []string) ([]importableResource, error) {
+	if len(types) == 0 {
+		return importableResources, nil
+	}
+
+	wanted := make(map[string]struct{})
+	for _, t := range types {
+		wanted[t] = struct{}{}
+	}
+
+	var selected []importableResource
+	for _, res := range importableResources {
+		if _, ok := wanted[res.Type]; ok {
+			selected = append(selected, res)
+			delete(wanted, res.Type)
+		}
+	}
+
+	if len(wanted) > 0 {
+		var unsupported []string
+		for t := range wanted {
+			unsupported = append(unsupported, t)
+		}
+		sort.Strings(unsupported)
+		return nil, fmt.Errorf("Unsupported resource types: %s", strings.Join(unsupported, ", "))
+	}
+
+	return selected, nil
+}
+
+// readImportableObject runs the resource's importer, if any, and then its Read function
+// for the object. It returns nil if the object no longer exists.
+func readImportableObject(r *schema.Resource, obj importableObject, config *Config) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(obj.ImportId)
+	for k, v := range obj.Fields {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Importer != nil && r.Importer.State != nil {
+		imported, err := r.Importer.State(d, config)
+		if err != nil {
+			return nil, err
+		}
+		if len(imported) != 1 {
+			return nil, fmt.Errorf("Expected a single imported resource, got %d", len(imported))
+		}
+		d = imported[0]
+	}
+
+	if err := r.Read(d, config); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	return d, nil
+}
+
+// importCommand returns the `terraform import` command for obj, or a comment if the
+// resource can't be imported.
+func importCommand(resourceType, name string, r *schema.Resource, obj importableObject) string {
+	if r.Importer == nil {
+		return fmt.Sprintf("# %s.%s (%s) does not support import", resourceType, name, obj.ImportId)
+	}
+
+	return fmt.Sprintf("terraform import %s.%s %s", resourceType, name, obj.ImportId)
+}
+
+// isConfigurableSchema returns whether a field can be set in configuration.
+func isConfigurableSchema(s *schema.Schema) bool {
+	if s.Removed != "" || s.Deprecated != "" {
+		return false
+	}
+
+	return s.Required || s.Optional
+}
+
+// hasStateAttribute returns whether the top-level field k was set by Read. Fields that
+// weren't set read back as their zero value, which could differ from their Default.
+func hasStateAttribute(attributes map[string]string, k string) bool {
+	for _, key := range []string{k, k + ".#", k + ".%"} {
+		if _, ok := attributes[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+var invalidHclNameChars = regexp.MustCompile("[^a-zA-Z0-9_-]")
+
+// uniqueHclResourceName turns an object name into a valid resource name that hasn't
+// been used yet for the resource type.
+func uniqueHclResourceName(objectName, resourceType string, used map[string]struct{}) string {
+	base := invalidHclNameChars.ReplaceAllString(objectName, "_")
+	if base == "" || !strings.ContainsAny(base[:1], "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_") {
+		base = "_" + base
+	}
+
+	name := base
+	for i := 2; ; i++ {
+		if _, ok := used[resourceType+"."+name]; !ok {
+			break
+		}
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[resourceType+"."+name] = struct{}{}
+
+	return name
+}
+
+// renderHclResource renders a resource block for the given values, which are keyed by
+// the top-level fields of the resource schema. Values of fields that aren't configurable
+// or that match what the provider would use if the field was unset are omitted.
+func renderHclResource(resourceType, name string, s map[string]*schema.Schema, values map[string]interface{}) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "resource %q %q {\n", resourceType, name)
+	renderHclBody(&buf, s, values, 1)
+	buf.WriteString("}\n")
+
+	return buf.String()
+}
+
+func renderHclBody(buf *bytes.Buffer, s map[string]*schema.Schema, values map[string]interface{}, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	var attributes, blocks []string
+	width := 0
+	for k, v := range values {
+		fieldSchema, ok := s[k]
+		if !ok || !isConfigurableSchema(fieldSchema) || isDefaultHclValue(fieldSchema, v) {
+			continue
+		}
+
+		if _, ok := fieldSchema.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+			continue
+		}
+
+		attributes = append(attributes, k)
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	for _, k := range attributes {
+		fmt.Fprintf(buf, "%s%-*s = %s\n", indent, width, k, renderHclValue(s[k], values[k], depth))
+	}
+
+	separate := len(attributes) > 0
+	for _, k := range blocks {
+		nested := s[k].Elem.(*schema.Resource)
+		for _, elem := range hclListElements(values[k]) {
+			m, ok := elem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if separate {
+				buf.WriteString("\n")
+			}
+			separate = true
+			fmt.Fprintf(buf, "%s%s {\n", indent, k)
+			renderHclBody(buf, nested.Schema, m, depth+1)
+			fmt.Fprintf(buf, "%s}\n", indent)
+		}
+	}
+}
+
+func renderHclValue(s *schema.Schema, v interface{}, depth int) string {
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		var elems []string
+		for _, elem := range hclListElements(v) {
+			elems = append(elems, renderHclPrimitive(elem))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case schema.TypeMap:
+		m, _ := v.(map[string]interface{})
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		indent := strings.Repeat("  ", depth)
+		var buf bytes.Buffer
+		buf.WriteString("{\n")
+		for _, k := range keys {
+			fmt.Fprintf(&buf, "%s  %s = %s\n", indent, hclString(k), renderHclPrimitive(m[k]))
+		}
+		buf.WriteString(indent + "}")
+		return buf.String()
+	default:
+		return renderHclPrimitive(v)
+	}
+}
+
+func renderHclPrimitive(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return hclString(t)
+	case bool:
+		return strconv.FormatBool(t)
+	case int:
+		return strconv.Itoa(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return hclString(fmt.Sprintf("%v", t))
+	}
+}
+
+// hclString quotes a string, escaping interpolation sequences.
+func hclString(s string) string {
+	return strings.Replace(strconv.Quote(s), "${", "$${", -1)
+}
+
+func hclListElements(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case *schema.Set:
+		return t.List()
+	case []interface{}:
+		return t
+	default:
+		return nil
+	}
+}
+
+// isDefaultHclValue returns whether v is what the field would hold if it wasn't set
+// in configuration: its schema Default if it has one, or the zero value of its type.
+// A zero value that differs from the Default, like false for a field defaulting to
+// true, has to be rendered or the generated configuration would change the object.
+func isDefaultHclValue(s *schema.Schema, v interface{}) bool {
+	if s.Default != nil {
+		return reflect.DeepEqual(v, s.Default)
+	}
+
+	return isZeroHclValue(v)
+}
+
+func isZeroHclValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	if set, ok := v.(*schema.Set); ok {
+		return set.Len() == 0
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	default:
+		return reflect.DeepEqual(v, reflect.Zero(rv.Type()).Interface())
+	}
+}
+
+func listComputeNetworksForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.Networks.List(project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, network := range resp.Items {
+			objects = append(objects, importableObject{Name: network.Name, ImportId: network.Name})
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+func listComputeSubnetworksForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.Subnetworks.AggregatedList(project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, scoped := range resp.Items {
+			for _, subnetwork := range scoped.Subnetworks {
+				region := GetResourceNameFromSelfLink(subnetwork.Region)
+				objects = append(objects, importableObject{
+					Name:     subnetwork.Name,
+					ImportId: fmt.Sprintf("%s/%s", region, subnetwork.Name),
+				})
+			}
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+func listComputeFirewallsForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.Firewalls.List(project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, firewall := range resp.Items {
+			objects = append(objects, importableObject{Name: firewall.Name, ImportId: firewall.Name})
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+func listComputeInstancesForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.Instances.AggregatedList(project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, scoped := range resp.Items {
+			for _, instance := range scoped.Instances {
+				objects = append(objects, importableObject{
+					Name:     instance.Name,
+					ImportId: instance.Name,
+					Fields: map[string]interface{}{
+						"name": instance.Name,
+						"zone": GetResourceNameFromSelfLink(instance.Zone),
+					},
+				})
+			}
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+func listComputeDisksForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.Disks.AggregatedList(project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, scoped := range resp.Items {
+			for _, disk := range scoped.Disks {
+				objects = append(objects, computeDiskImportableObject(disk))
+			}
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+// computeDiskImportableObject identifies disk by zone and name, as disk names are
+// only unique within a zone.
+func computeDiskImportableObject(disk *compute.Disk) importableObject {
+	zone := GetResourceNameFromSelfLink(disk.Zone)
+	return importableObject{
+		Name:     disk.Name,
+		ImportId: fmt.Sprintf("%s/%s", zone, disk.Name),
+	}
+}
+
+func listStorageBucketsForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientStorage.Buckets.List(project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, bucket := range resp.Items {
+			objects = append(objects, importableObject{Name: bucket.Name, ImportId: bucket.Name})
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+func listPubsubTopicsForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientPubsub.Projects.Topics.List("projects/" + project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, topic := range resp.Topics {
+			name := GetResourceNameFromSelfLink(topic.Name)
+			objects = append(objects, importableObject{Name: name, ImportId: name})
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+func listSqlDatabaseInstancesForImport(config *Config, project string) ([]importableObject, error) {
+	var objects []importableObject
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientSqlAdmin.Instances.List(project).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range resp.Items {
+			objects = append(objects, importableObject{Name: instance.Name, ImportId: instance.Name})
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+func listContainerClustersForImport(config *Config, project string) ([]importableObject, error) {
+	resp, err := config.clientContainer.Projects.Locations.Clusters.List(containerLocationName(project, "-")).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []importableObject
+	for _, cluster := range resp.Clusters {
+		objects = append(objects, importableObject{
+			Name:     cluster.Name,
+			ImportId: fmt.Sprintf("%s/%s", cluster.Location, cluster.Name),
+		})
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,235 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func TestRenderHclResource(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"self_link": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"auto_create_subnetworks": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"direction": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "INGRESS",
+		},
+		"disabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"priority": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"source_ranges": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     schema.TypeString,
+		},
+		"ipv4_range": {
+			Type:       schema.TypeString,
+			Optional:   true,
+			Deprecated: "Please use google_compute_subnetwork resources instead.",
+		},
+		"allow": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"protocol": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"ports": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"fingerprint": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
+	values := map[string]interface{}{
+		"name":                    "my-firewall",
+		"description":             "Managed by ${team}",
+		"self_link":               "https://www.googleapis.com/compute/v1/projects/my-project/global/firewalls/my-firewall",
+		"auto_create_subnetworks": false,
+		"direction":               "INGRESS",
+		"disabled":                false,
+		"priority":                1000,
+		"source_ranges":           schema.NewSet(schema.HashString, []interface{}{"10.0.0.0/8"}),
+		"labels":                  map[string]interface{}{"env": "prod"},
+		"ipv4_range":              "10.0.0.0/16",
+		"allow": []interface{}{
+			map[string]interface{}{
+				"protocol":    "tcp",
+				"ports":       []interface{}{"80", "443"},
+				"fingerprint": "abc",
+			},
+			map[string]interface{}{
+				"protocol": "icmp",
+				"ports":    []interface{}{},
+			},
+		},
+	}
+
+	expected := `resource "google_compute_firewall" "my-firewall" {
+  auto_create_subnetworks = false
+  description             = "Managed by $${team}"
+  labels                  = {
+    "env" = "prod"
+  }
+  name                    = "my-firewall"
+  priority                = 1000
+  source_ranges           = ["10.0.0.0/8"]
+
+  allow {
+    ports    = ["80", "443"]
+    protocol = "tcp"
+  }
+
+  allow {
+    protocol = "icmp"
+  }
+}
+`
+
+	if actual := renderHclResource("google_compute_firewall", "my-firewall", s, values); actual != expected {
+		t.Errorf("Unexpected configuration.\nExpected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
+
+func TestHasStateAttribute(t *testing.T) {
+	attributes := map[string]string{
+		"id":                      "my-network",
+		"auto_create_subnetworks": "false",
+		"labels.%":                "1",
+		"labels.env":              "prod",
+		"source_ranges.#":         "0",
+	}
+
+	cases := map[string]bool{
+		"auto_create_subnetworks": true,
+		"labels":                  true,
+		"source_ranges":           true,
+		"description":             false,
+		"env":                     false,
+	}
+
+	for k, expected := range cases {
+		if actual := hasStateAttribute(attributes, k); actual != expected {
+			t.Errorf("Expected hasStateAttribute(%q) to be %t, got %t", k, expected, actual)
+		}
+	}
+}
+
+func TestUniqueHclResourceName(t *testing.T) {
+	used := make(map[string]struct{})
+	cases := []struct {
+		ObjectName   string
+		ResourceType string
+		Expected     string
+	}{
+		{"my-instance", "google_compute_instance", "my-instance"},
+		{"my-instance", "google_compute_instance", "my-instance_2"},
+		{"my-instance", "google_compute_disk", "my-instance"},
+		{"my.bucket.example.com", "google_storage_bucket", "my_bucket_example_com"},
+		{"1-bucket", "google_storage_bucket", "_1-bucket"},
+	}
+
+	for _, tc := range cases {
+		if actual := uniqueHclResourceName(tc.ObjectName, tc.ResourceType, used); actual != tc.Expected {
+			t.Errorf("Expected name %q for %s %q, got %q", tc.Expected, tc.ResourceType, tc.ObjectName, actual)
+		}
+	}
+}
+
+func TestSelectImportableResources(t *testing.T) {
+	all, err := selectImportableResources(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(all) != len(importableResources) {
+		t.Errorf("Expected all %d resource types to be selected, got %d", len(importableResources), len(all))
+	}
+
+	selected, err := selectImportableResources([]string{"google_storage_bucket", "google_compute_network"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(selected) != 2 || selected[0].Type != "google_compute_network" || selected[1].Type != "google_storage_bucket" {
+		t.Errorf("Expected network and bucket in generation order, got %v", selected)
+	}
+
+	if _, err := selectImportableResources([]string{"google_compute_route"}); err == nil {
+		t.Errorf("Expected an error for an unsupported resource type")
+	}
+}
+
+func TestImportableResourcesAreProviderResources(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	for _, res := range importableResources {
+		if _, ok := provider.ResourcesMap[res.Type]; !ok {
+			t.Errorf("%s is not a resource of the provider", res.Type)
+		}
+	}
+}
+
+func TestComputeDiskImportCommand(t *testing.T) {
+	disk := &compute.Disk{
+		Name: "my-disk",
+		Zone: "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-east1-b",
+	}
+	obj := computeDiskImportableObject(disk)
+	r := Provider().(*schema.Provider).ResourcesMap["google_compute_disk"]
+
+	expected := "terraform import google_compute_disk.my-disk us-east1-b/my-disk"
+	if actual := importCommand("google_compute_disk", "my-disk", r, obj); actual != expected {
+		t.Fatalf("bad: %q, expected %q", actual, expected)
+	}
+
+	d := r.Data(nil)
+	d.SetId(obj.ImportId)
+	imported, err := r.Importer.State(d, nil)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("bad: expected a single imported resource, got %d", len(imported))
+	}
+	if zone := imported[0].Get("zone").(string); zone != "us-east1-b" {
+		t.Fatalf("bad: zone %q, expected %q", zone, "us-east1-b")
+	}
+	if id := imported[0].Id(); id != "my-disk" {
+		t.Fatalf("bad: id %q, expected %q", id, "my-disk")
+	}
+}
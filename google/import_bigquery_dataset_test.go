@@ -31,3 +31,29 @@ func TestAccBigQueryDataset_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBigQueryDataset_importAccess(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "google_bigquery_dataset.access_test"
+	datasetID := fmt.Sprintf("tf_test_access_%s", acctest.RandString(10))
+	otherDatasetID := fmt.Sprintf("tf_test_other_%s", acctest.RandString(10))
+	viewID := fmt.Sprintf("tf_test_view_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryDatasetWithViewAccess(getTestProjectFromEnv(), datasetID, otherDatasetID, viewID),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -28,6 +28,13 @@ func TestAccComputeDisk_importBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("us-central1-a/%s", diskName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -32,3 +32,28 @@ func TestAccContainerCluster_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccContainerCluster_importRegional(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "google_container_cluster.regional"
+	name := fmt.Sprintf("tf-cluster-test-%s", acctest.RandString(10))
+	conf := testAccContainerCluster_regional(name)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: conf,
+			},
+
+			resource.TestStep{
+				ResourceName:        resourceName,
+				ImportStateIdPrefix: "us-central1/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPubsubSnapshot_import(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+	snapshot := fmt.Sprintf("tf-test-snapshot-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPubsubSnapshot_basic(topic, subscription, snapshot, "bar"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_pubsub_snapshot.foo",
+				ImportStateId:     snapshot,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API doesn't return the subscription a snapshot was taken from,
+				// the diff against the configured one is suppressed after import.
+				ImportStateVerifyIgnore: []string{"subscription"},
+			},
+		},
+	})
+}
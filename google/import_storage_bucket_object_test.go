@@ -0,0 +1,34 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGoogleStorageObject_importBasic(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageBucketsObjectContent(bucketName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_storage_bucket_object.object",
+				ImportStateId:     fmt.Sprintf("%s/%s", bucketName, objectName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The content isn't read back, the diff against the configured
+				// content is suppressed when its md5 hash matches instead.
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccStorageNotification_import(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.RandomWithPrefix("tf-test-notification")
+	topicName := acctest.RandomWithPrefix("tf-pubsub")
+	topic := fmt.Sprintf("//pubsub.googleapis.com/projects/%s/topics/%s", getTestProjectFromEnv(), topicName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageNotificationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageNotificationBasic(bucketName, topicName, topic),
+			},
+			resource.TestStep{
+				ResourceName:      "google_storage_notification.notification",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
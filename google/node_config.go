@@ -3,7 +3,7 @@ package google
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"google.golang.org/api/container/v1"
+	containerBeta "google.golang.org/api/container/v1beta1"
 )
 
 var schemaNodeConfig = &schema.Schema{
@@ -22,6 +22,28 @@ var schemaNodeConfig = &schema.Schema{
 				ValidateFunc: validation.IntAtLeast(10),
 			},
 
+			"guest_accelerator": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+					},
+				},
+			},
+
 			"image_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -98,20 +120,79 @@ var schemaNodeConfig = &schema.Schema{
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"taint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NO_SCHEDULE", "PREFER_NO_SCHEDULE", "NO_EXECUTE"}, false),
+						},
+					},
+				},
+			},
+
+			"workload_metadata_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_metadata": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"UNSPECIFIED", "SECURE", "EXPOSE"}, false),
+						},
+					},
+				},
+			},
 		},
 	},
 }
 
-func expandNodeConfig(v interface{}) *container.NodeConfig {
+func expandNodeConfig(v interface{}) *containerBeta.NodeConfig {
 	nodeConfigs := v.([]interface{})
 	nodeConfig := nodeConfigs[0].(map[string]interface{})
 
-	nc := &container.NodeConfig{}
+	nc := &containerBeta.NodeConfig{}
 
 	if v, ok := nodeConfig["machine_type"]; ok {
 		nc.MachineType = v.(string)
 	}
 
+	if v, ok := nodeConfig["guest_accelerator"]; ok {
+		accels := v.([]interface{})
+		guestAccelerators := make([]*containerBeta.AcceleratorConfig, 0, len(accels))
+		for _, raw := range accels {
+			data := raw.(map[string]interface{})
+			if data["count"].(int) == 0 {
+				continue
+			}
+			guestAccelerators = append(guestAccelerators, &containerBeta.AcceleratorConfig{
+				AcceleratorCount: int64(data["count"].(int)),
+				AcceleratorType:  data["type"].(string),
+			})
+		}
+		nc.Accelerators = guestAccelerators
+	}
+
 	if v, ok := nodeConfig["disk_size_gb"]; ok {
 		nc.DiskSizeGb = int64(v.(int))
 	}
@@ -169,10 +250,32 @@ func expandNodeConfig(v interface{}) *container.NodeConfig {
 		nc.MinCpuPlatform = v.(string)
 	}
 
+	if v, ok := nodeConfig["taint"]; ok && len(v.([]interface{})) > 0 {
+		taints := v.([]interface{})
+		nodeTaints := make([]*containerBeta.NodeTaint, 0, len(taints))
+		for _, raw := range taints {
+			data := raw.(map[string]interface{})
+			taint := &containerBeta.NodeTaint{
+				Key:    data["key"].(string),
+				Value:  data["value"].(string),
+				Effect: data["effect"].(string),
+			}
+			nodeTaints = append(nodeTaints, taint)
+		}
+		nc.Taints = nodeTaints
+	}
+
+	if v, ok := nodeConfig["workload_metadata_config"]; ok && len(v.([]interface{})) > 0 {
+		conf := v.([]interface{})[0].(map[string]interface{})
+		nc.WorkloadMetadataConfig = &containerBeta.WorkloadMetadataConfig{
+			NodeMetadata: conf["node_metadata"].(string),
+		}
+	}
+
 	return nc
 }
 
-func flattenNodeConfig(c *container.NodeConfig) []map[string]interface{} {
+func flattenNodeConfig(c *containerBeta.NodeConfig) []map[string]interface{} {
 	config := make([]map[string]interface{}, 0, 1)
 
 	if c == nil {
@@ -180,16 +283,19 @@ func flattenNodeConfig(c *container.NodeConfig) []map[string]interface{} {
 	}
 
 	config = append(config, map[string]interface{}{
-		"machine_type":     c.MachineType,
-		"disk_size_gb":     c.DiskSizeGb,
-		"local_ssd_count":  c.LocalSsdCount,
-		"service_account":  c.ServiceAccount,
-		"metadata":         c.Metadata,
-		"image_type":       c.ImageType,
-		"labels":           c.Labels,
-		"tags":             c.Tags,
-		"preemptible":      c.Preemptible,
-		"min_cpu_platform": c.MinCpuPlatform,
+		"machine_type":             c.MachineType,
+		"disk_size_gb":             c.DiskSizeGb,
+		"guest_accelerator":        flattenContainerGuestAccelerators(c.Accelerators),
+		"local_ssd_count":          c.LocalSsdCount,
+		"service_account":          c.ServiceAccount,
+		"metadata":                 c.Metadata,
+		"image_type":               c.ImageType,
+		"labels":                   c.Labels,
+		"tags":                     c.Tags,
+		"preemptible":              c.Preemptible,
+		"min_cpu_platform":         c.MinCpuPlatform,
+		"taint":                    flattenTaints(c.Taints),
+		"workload_metadata_config": flattenWorkloadMetadataConfig(c.WorkloadMetadataConfig),
 	})
 
 	if len(c.OauthScopes) > 0 {
@@ -198,3 +304,36 @@ func flattenNodeConfig(c *container.NodeConfig) []map[string]interface{} {
 
 	return config
 }
+
+func flattenContainerGuestAccelerators(c []*containerBeta.AcceleratorConfig) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, accel := range c {
+		result = append(result, map[string]interface{}{
+			"count": accel.AcceleratorCount,
+			"type":  accel.AcceleratorType,
+		})
+	}
+	return result
+}
+
+func flattenTaints(c []*containerBeta.NodeTaint) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, taint := range c {
+		result = append(result, map[string]interface{}{
+			"key":    taint.Key,
+			"value":  taint.Value,
+			"effect": taint.Effect,
+		})
+	}
+	return result
+}
+
+func flattenWorkloadMetadataConfig(c *containerBeta.WorkloadMetadataConfig) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	if c != nil {
+		result = append(result, map[string]interface{}{
+			"node_metadata": c.NodeMetadata,
+		})
+	}
+	return result
+}
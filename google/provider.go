@@ -49,16 +49,21 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"google_dns_managed_zone":          dataSourceDnsManagedZone(),
-			"google_client_config":             dataSourceGoogleClientConfig(),
-			"google_compute_lb_ip_ranges":      dataSourceGoogleComputeLbIpRanges(),
-			"google_compute_network":           dataSourceGoogleComputeNetwork(),
-			"google_compute_subnetwork":        dataSourceGoogleComputeSubnetwork(),
-			"google_compute_zones":             dataSourceGoogleComputeZones(),
-			"google_compute_instance_group":    dataSourceGoogleComputeInstanceGroup(),
-			"google_container_engine_versions": dataSourceGoogleContainerEngineVersions(),
-			"google_iam_policy":                dataSourceGoogleIamPolicy(),
-			"google_storage_object_signed_url": dataSourceGoogleSignedUrl(),
+			"google_dns_managed_zone":                         dataSourceDnsManagedZone(),
+			"google_client_config":                            dataSourceGoogleClientConfig(),
+			"google_compute_lb_ip_ranges":                     dataSourceGoogleComputeLbIpRanges(),
+			"google_compute_network":                          dataSourceGoogleComputeNetwork(),
+			"google_compute_subnetwork":                       dataSourceGoogleComputeSubnetwork(),
+			"google_compute_zones":                            dataSourceGoogleComputeZones(),
+			"google_compute_instance_group":                   dataSourceGoogleComputeInstanceGroup(),
+			"google_container_cluster":                        dataSourceGoogleContainerCluster(),
+			"google_container_engine_versions":                dataSourceGoogleContainerEngineVersions(),
+			"google_iam_policy":                               dataSourceGoogleIamPolicy(),
+			"google_pubsub_topic":                             dataSourceGooglePubsubTopic(),
+			"google_storage_object_signed_url":                dataSourceGoogleSignedUrl(),
+			"google_storage_signed_post_policy":               dataSourceGoogleSignedPostPolicy(),
+			"google_storage_project_service_account":          dataSourceGoogleStorageProjectServiceAccount(),
+			"google_storage_transfer_project_service_account": dataSourceGoogleStorageTransferProjectServiceAccount(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -121,8 +126,11 @@ func Provider() terraform.ResourceProvider {
 			"google_sourcerepo_repository":                 resourceSourceRepoRepository(),
 			"google_spanner_instance":                      resourceSpannerInstance(),
 			"google_spanner_database":                      resourceSpannerDatabase(),
+			"google_sql_backup_run":                        resourceSqlBackupRun(),
 			"google_sql_database":                          resourceSqlDatabase(),
 			"google_sql_database_instance":                 resourceSqlDatabaseInstance(),
+			"google_sql_database_instance_import":          resourceSqlDatabaseInstanceImport(),
+			"google_sql_ssl_cert":                          resourceSqlSslCert(),
 			"google_sql_user":                              resourceSqlUser(),
 			"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
 			"google_project":                               resourceGoogleProject(),
@@ -132,6 +140,7 @@ func Provider() terraform.ResourceProvider {
 			"google_project_services":                      resourceGoogleProjectServices(),
 			"google_pubsub_topic":                          resourcePubsubTopic(),
 			"google_pubsub_subscription":                   resourcePubsubSubscription(),
+			"google_pubsub_snapshot":                       resourcePubsubSnapshot(),
 			"google_runtimeconfig_config":                  resourceRuntimeconfigConfig(),
 			"google_runtimeconfig_variable":                resourceRuntimeconfigVariable(),
 			"google_service_account":                       resourceGoogleServiceAccount(),
@@ -139,7 +148,11 @@ func Provider() terraform.ResourceProvider {
 			"google_storage_bucket":                        resourceStorageBucket(),
 			"google_storage_bucket_acl":                    resourceStorageBucketAcl(),
 			"google_storage_bucket_object":                 resourceStorageBucketObject(),
+			"google_storage_bucket_objects":                resourceStorageBucketObjects(),
+			"google_storage_default_object_acl":            resourceStorageDefaultObjectAcl(),
+			"google_storage_notification":                  resourceStorageNotification(),
 			"google_storage_object_acl":                    resourceStorageObjectAcl(),
+			"google_storage_transfer_job":                  resourceStorageTransferJob(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -107,6 +107,108 @@ func resourceBigQueryDataset() *schema.Resource {
 				Elem:     schema.TypeString,
 			},
 
+			// Access: [Optional] An array of objects that define dataset access
+			// for one or more entities. If unspecified at dataset creation time,
+			// BigQuery adds default dataset access for the following entities:
+			// access.specialGroup: projectReaders; access.role: READER;
+			// access.specialGroup: projectWriters; access.role: WRITER;
+			// access.specialGroup: projectOwners; access.role: OWNER;
+			// access.userByEmail: [dataset creator email]; access.role: OWNER;
+			"access": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				// Computed so the entries added by the server when no access is
+				// configured don't show up as a diff.
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Role: [Required] Describes the rights granted to the user
+						// specified by the other member of the access object. The
+						// following string values are supported: READER, WRITER, OWNER.
+						// Not set for entries granting access to a view.
+						"role": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						// UserByEmail: [Pick one] An email address of a user to grant
+						// access to.
+						"user_by_email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						// GroupByEmail: [Pick one] An email address of a Google Group
+						// to grant access to.
+						"group_by_email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						// Domain: [Pick one] A domain to grant access to. Any users
+						// signed in with the domain specified will be granted the
+						// specified access.
+						"domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						// SpecialGroup: [Pick one] A special group to grant access to.
+						// Possible values include: projectOwners, projectReaders,
+						// projectWriters and allAuthenticatedUsers.
+						"special_group": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						// View: [Pick one] A view from a different dataset to grant
+						// access to. Queries executed against that view will have read
+						// access to tables in this dataset. The role field is not
+						// required when this field is set.
+						"view": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"project_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"dataset_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"table_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// DefaultEncryptionConfiguration: [Optional] The default encryption
+			// key for all tables in the dataset. Once this property is set, all
+			// newly-created partitioned tables in the dataset will have encryption
+			// key set to this value, unless table creation request (or query)
+			// overrides the key.
+			"default_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			// SelfLink: [Output-only] A URL that can be used to access the resource
 			// again. You can use this URL in Get or Update requests to the
 			// resource.
@@ -179,9 +281,117 @@ func resourceDataset(d *schema.ResourceData, meta interface{}) (*bigquery.Datase
 		dataset.Labels = labels
 	}
 
+	if v, ok := d.GetOk("access"); ok {
+		access, err := expandBigQueryDatasetAccess(v.(*schema.Set).List())
+		if err != nil {
+			return nil, err
+		}
+
+		dataset.Access = access
+	}
+
+	if v, ok := d.GetOk("default_encryption_configuration"); ok {
+		dataset.DefaultEncryptionConfiguration = expandBigQueryDatasetEncryptionConfiguration(v.([]interface{}))
+	}
+
 	return dataset, nil
 }
 
+func expandBigQueryDatasetAccess(configured []interface{}) ([]*bigquery.DatasetAccess, error) {
+	access := make([]*bigquery.DatasetAccess, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+
+		entry := &bigquery.DatasetAccess{
+			Role:         data["role"].(string),
+			UserByEmail:  data["user_by_email"].(string),
+			GroupByEmail: data["group_by_email"].(string),
+			Domain:       data["domain"].(string),
+			SpecialGroup: data["special_group"].(string),
+		}
+
+		if v, ok := data["view"]; ok && len(v.([]interface{})) > 0 {
+			view := v.([]interface{})[0].(map[string]interface{})
+			entry.View = &bigquery.TableReference{
+				ProjectId: view["project_id"].(string),
+				DatasetId: view["dataset_id"].(string),
+				TableId:   view["table_id"].(string),
+			}
+		}
+
+		members := 0
+		for _, member := range []string{entry.UserByEmail, entry.GroupByEmail, entry.Domain, entry.SpecialGroup} {
+			if member != "" {
+				members++
+			}
+		}
+		if entry.View != nil {
+			members++
+		}
+		if members != 1 {
+			return nil, fmt.Errorf("each access entry must set exactly one of user_by_email, group_by_email, domain, special_group or view")
+		}
+
+		if entry.View == nil && entry.Role == "" {
+			return nil, fmt.Errorf("role is required for access entries that don't grant access to a view")
+		}
+
+		access = append(access, entry)
+	}
+
+	return access, nil
+}
+
+func flattenBigQueryDatasetAccess(access []*bigquery.DatasetAccess) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(access))
+	for _, entry := range access {
+		data := map[string]interface{}{
+			"role":           entry.Role,
+			"user_by_email":  entry.UserByEmail,
+			"group_by_email": entry.GroupByEmail,
+			"domain":         entry.Domain,
+			"special_group":  entry.SpecialGroup,
+		}
+
+		if entry.View != nil {
+			data["view"] = []map[string]interface{}{
+				{
+					"project_id": entry.View.ProjectId,
+					"dataset_id": entry.View.DatasetId,
+					"table_id":   entry.View.TableId,
+				},
+			}
+		}
+
+		flattened = append(flattened, data)
+	}
+
+	return flattened
+}
+
+func expandBigQueryDatasetEncryptionConfiguration(configured []interface{}) *bigquery.EncryptionConfiguration {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &bigquery.EncryptionConfiguration{
+		KmsKeyName: data["kms_key_name"].(string),
+	}
+}
+
+func flattenBigQueryDatasetEncryptionConfiguration(encryption *bigquery.EncryptionConfiguration) []map[string]interface{} {
+	if encryption == nil || encryption.KmsKeyName == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"kms_key_name": encryption.KmsKeyName,
+		},
+	}
+}
+
 func resourceBigQueryDatasetCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -237,6 +447,14 @@ func resourceBigQueryDatasetRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("dataset_id", res.DatasetReference.DatasetId)
 	d.Set("default_table_expiration_ms", res.DefaultTableExpirationMs)
 
+	if err := d.Set("access", flattenBigQueryDatasetAccess(res.Access)); err != nil {
+		return fmt.Errorf("Error setting access: %s", err)
+	}
+
+	if err := d.Set("default_encryption_configuration", flattenBigQueryDatasetEncryptionConfiguration(res.DefaultEncryptionConfiguration)); err != nil {
+		return fmt.Errorf("Error setting default_encryption_configuration: %s", err)
+	}
+
 	// Older Tables in BigQuery have no Location set in the API response. This may be an issue when importing
 	// tables created before BigQuery was available in multiple zones. We can safely assume that these tables
 	// are in the US, as this was the default at the time.
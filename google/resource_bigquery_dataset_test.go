@@ -2,13 +2,49 @@ package google
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/bigquery/v2"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_bigquery_dataset", &resource.Sweeper{
+		Name: "gcp_bigquery_dataset",
+		F:    testSweepBigQueryDatasets,
+	})
+}
+
+func testSweepBigQueryDatasets(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientBigQuery.Datasets.List(config.Project).All(true).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing BigQuery datasets: %s", err)
+		}
+
+		for _, dataset := range found.Datasets {
+			datasetId := dataset.DatasetReference.DatasetId
+			sweepResource("BigQuery dataset", datasetId, func() error {
+				return config.clientBigQuery.Datasets.Delete(config.Project, datasetId).DeleteContents(true).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccBigQueryDataset_basic(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +74,136 @@ func TestAccBigQueryDataset_basic(t *testing.T) {
 	})
 }
 
+func TestAccBigQueryDataset_access(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_access_%s", acctest.RandString(10))
+	otherDatasetID := fmt.Sprintf("tf_test_other_%s", acctest.RandString(10))
+	viewID := fmt.Sprintf("tf_test_view_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryDatasetWithOneAccess(datasetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBigQueryDatasetExists("google_bigquery_dataset.access_test"),
+					resource.TestCheckResourceAttr("google_bigquery_dataset.access_test", "access.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccBigQueryDatasetWithViewAccess(getTestProjectFromEnv(), datasetID, otherDatasetID, viewID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBigQueryDatasetExists("google_bigquery_dataset.access_test"),
+					resource.TestCheckResourceAttr("google_bigquery_dataset.access_test", "access.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestBigQueryDatasetAccess_expandFlatten(t *testing.T) {
+	cases := map[string][]*bigquery.DatasetAccess{
+		"empty": {},
+		"members": {
+			{Role: "OWNER", SpecialGroup: "projectOwners"},
+			{Role: "WRITER", UserByEmail: "writer@example.com"},
+			{Role: "READER", GroupByEmail: "readers@example.com"},
+			{Role: "READER", Domain: "example.com"},
+		},
+		"authorized view": {
+			{
+				View: &bigquery.TableReference{
+					ProjectId: "my-project",
+					DatasetId: "other_dataset",
+					TableId:   "my_view",
+				},
+			},
+		},
+	}
+
+	for tn, access := range cases {
+		// Round trip through the same types the schema stores.
+		configured := make([]interface{}, 0, len(access))
+		for _, entry := range flattenBigQueryDatasetAccess(access) {
+			raw := map[string]interface{}{}
+			for k, v := range entry {
+				raw[k] = v
+			}
+			raw["view"] = []interface{}{}
+			if views, ok := entry["view"].([]map[string]interface{}); ok {
+				raw["view"] = []interface{}{views[0]}
+			}
+			configured = append(configured, raw)
+		}
+
+		got, err := expandBigQueryDatasetAccess(configured)
+		if err != nil {
+			t.Fatalf("bad: %s, %s", tn, err)
+		}
+		if !reflect.DeepEqual(got, access) {
+			t.Errorf("bad: %s, expected %#v, got %#v", tn, access, got)
+		}
+	}
+}
+
+func TestBigQueryDatasetAccess_expandInvalid(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"no member": {
+			"role": "READER",
+		},
+		"several members": {
+			"role":          "READER",
+			"domain":        "example.com",
+			"special_group": "projectReaders",
+		},
+		"member without role": {
+			"user_by_email": "reader@example.com",
+		},
+	}
+
+	for tn, entry := range cases {
+		raw := map[string]interface{}{
+			"role":           "",
+			"user_by_email":  "",
+			"group_by_email": "",
+			"domain":         "",
+			"special_group":  "",
+			"view":           []interface{}{},
+		}
+		for k, v := range entry {
+			raw[k] = v
+		}
+
+		if _, err := expandBigQueryDatasetAccess([]interface{}{raw}); err == nil {
+			t.Errorf("bad: %s, expected an error", tn)
+		}
+	}
+}
+
+func TestBigQueryDatasetEncryptionConfiguration_expandFlatten(t *testing.T) {
+	cases := map[string]*bigquery.EncryptionConfiguration{
+		"unset": nil,
+		"kms key": {
+			KmsKeyName: "projects/my-project/locations/us/keyRings/my-ring/cryptoKeys/my-key",
+		},
+	}
+
+	for tn, encryption := range cases {
+		configured := []interface{}{}
+		for _, c := range flattenBigQueryDatasetEncryptionConfiguration(encryption) {
+			configured = append(configured, c)
+		}
+
+		if got := expandBigQueryDatasetEncryptionConfiguration(configured); !reflect.DeepEqual(got, encryption) {
+			t.Errorf("bad: %s, expected %#v, got %#v", tn, encryption, got)
+		}
+	}
+}
+
 func testAccCheckBigQueryDatasetDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -112,3 +278,54 @@ resource "google_bigquery_dataset" "test" {
   }
 }`, datasetID)
 }
+
+func testAccBigQueryDatasetWithOneAccess(datasetID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "access_test" {
+  dataset_id = "%s"
+
+  access {
+    role          = "OWNER"
+    special_group = "projectOwners"
+  }
+}`, datasetID)
+}
+
+func testAccBigQueryDatasetWithViewAccess(project, datasetID, otherDatasetID, viewID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "other_dataset" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "table_with_view" {
+  table_id   = "%s"
+  dataset_id = "${google_bigquery_dataset.other_dataset.dataset_id}"
+
+  view {
+    query          = "SELECT state FROM [lookerdata:cdc.project_tycho_reports]"
+    use_legacy_sql = true
+  }
+}
+
+resource "google_bigquery_dataset" "access_test" {
+  dataset_id = "%s"
+
+  access {
+    role          = "OWNER"
+    special_group = "projectOwners"
+  }
+
+  access {
+    role   = "READER"
+    domain = "example.com"
+  }
+
+  access {
+    view {
+      project_id = "%s"
+      dataset_id = "${google_bigquery_dataset.other_dataset.dataset_id}"
+      table_id   = "${google_bigquery_table.table_with_view.table_id}"
+    }
+  }
+}`, otherDatasetID, viewID, datasetID, project)
+}
@@ -10,6 +10,42 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_bigtable_instance", &resource.Sweeper{
+		Name: "gcp_bigtable_instance",
+		F:    testSweepBigtableInstances,
+	})
+}
+
+func testSweepBigtableInstances(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	c, err := config.bigtableClientFactory.NewInstanceAdminClient(config.Project)
+	if err != nil {
+		return fmt.Errorf("error starting instance admin client: %s", err)
+	}
+
+	defer c.Close()
+
+	instances, err := c.Instances(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing Bigtable instances: %s", err)
+	}
+
+	for _, instance := range instances {
+		name := instance.Name
+		sweepResource("Bigtable instance", name, func() error {
+			return c.DeleteInstance(ctx, name)
+		})
+	}
+
+	return nil
+}
+
 func TestAccBigtableInstance_basic(t *testing.T) {
 	t.Parallel()
 
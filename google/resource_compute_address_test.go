@@ -10,6 +10,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_address", &resource.Sweeper{
+		Name:         "gcp_compute_address",
+		Dependencies: []string{"gcp_compute_forwarding_rule", "gcp_compute_instance"},
+		F:            testSweepComputeAddresses,
+	})
+}
+
+func testSweepComputeAddresses(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Addresses.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing addresses: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "address", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Addresses.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 // Unit tests
 
 func TestComputeAddressIdParsing(t *testing.T) {
@@ -10,6 +10,47 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_autoscaler", &resource.Sweeper{
+		Name: "gcp_compute_autoscaler",
+		F:    testSweepComputeAutoscalers,
+	})
+}
+
+func testSweepComputeAutoscalers(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	zones, err := getSweeperZones(config, region)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range zones {
+		token := ""
+		for paginate := true; paginate; {
+			found, err := config.clientCompute.Autoscalers.List(config.Project, zone).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("error listing autoscalers in zone %s: %s", zone, err)
+			}
+
+			for _, item := range found.Items {
+				name := item.Name
+				sweepComputeResource(config, "autoscaler", name, func() (*compute.Operation, error) {
+					return config.clientCompute.Autoscalers.Delete(config.Project, zone, name).Do()
+				})
+			}
+
+			token = found.NextPageToken
+			paginate = token != ""
+		}
+	}
+
+	return nil
+}
+
 func TestAccComputeAutoscaler_basic(t *testing.T) {
 	t.Parallel()
 
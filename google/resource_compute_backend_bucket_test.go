@@ -10,6 +10,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_backend_bucket", &resource.Sweeper{
+		Name:         "gcp_compute_backend_bucket",
+		Dependencies: []string{"gcp_compute_url_map"},
+		F:            testSweepComputeBackendBuckets,
+	})
+}
+
+func testSweepComputeBackendBuckets(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.BackendBuckets.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing backend buckets: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "backend bucket", name, func() (*compute.Operation, error) {
+				return config.clientCompute.BackendBuckets.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeBackendBucket_basic(t *testing.T) {
 	t.Parallel()
 
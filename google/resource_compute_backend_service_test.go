@@ -10,6 +10,45 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_backend_service", &resource.Sweeper{
+		Name: "gcp_compute_backend_service",
+		Dependencies: []string{
+			"gcp_compute_url_map",
+			"gcp_compute_target_ssl_proxy",
+			"gcp_compute_target_tcp_proxy",
+		},
+		F: testSweepComputeBackendServices,
+	})
+}
+
+func testSweepComputeBackendServices(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.BackendServices.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing backend services: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "backend service", name, func() (*compute.Operation, error) {
+				return config.clientCompute.BackendServices.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeBackendService_basic(t *testing.T) {
 	t.Parallel()
 
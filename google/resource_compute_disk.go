@@ -26,7 +26,7 @@ func resourceComputeDisk() *schema.Resource {
 		Update: resourceComputeDiskUpdate,
 		Delete: resourceComputeDiskDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceComputeDiskImportState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -386,3 +386,19 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+func resourceComputeDiskImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	switch len(parts) {
+	case 1:
+		// Only the name is known, Read searches for the disk in the zones of the region.
+	case 2:
+		d.Set("zone", parts[0])
+		d.Set("name", parts[1])
+		d.SetId(parts[1])
+	default:
+		return nil, fmt.Errorf("Invalid compute disk specifier. Expecting {zone}/{name} or {name}")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
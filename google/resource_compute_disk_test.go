@@ -12,6 +12,48 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_disk", &resource.Sweeper{
+		Name:         "gcp_compute_disk",
+		Dependencies: []string{"gcp_compute_instance"},
+		F:            testSweepComputeDisks,
+	})
+}
+
+func testSweepComputeDisks(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	zones, err := getSweeperZones(config, region)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range zones {
+		token := ""
+		for paginate := true; paginate; {
+			found, err := config.clientCompute.Disks.List(config.Project, zone).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("error listing disks in zone %s: %s", zone, err)
+			}
+
+			for _, item := range found.Items {
+				name := item.Name
+				sweepComputeResource(config, "disk", name, func() (*compute.Operation, error) {
+					return config.clientCompute.Disks.Delete(config.Project, zone, name).Do()
+				})
+			}
+
+			token = found.NextPageToken
+			paginate = token != ""
+		}
+	}
+
+	return nil
+}
+
 func TestAccComputeDisk_basic(t *testing.T) {
 	t.Parallel()
 
@@ -402,7 +402,7 @@ func resourceComputeFirewallDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceFirewall(d *schema.ResourceData, meta interface{}, computeApiVersion ComputeApiVersion) (*computeBeta.Firewall, error) {
+func resourceFirewall(d *schema.ResourceData, meta interface{}, computeApiVersion ApiVersion) (*computeBeta.Firewall, error) {
 	config := meta.(*Config)
 
 	network, err := ParseNetworkFieldValue(d.Get("network").(string), d, config)
@@ -14,6 +14,40 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_firewall", &resource.Sweeper{
+		Name: "gcp_compute_firewall",
+		F:    testSweepComputeFirewalls,
+	})
+}
+
+func testSweepComputeFirewalls(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Firewalls.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing firewalls: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "firewall", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Firewalls.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeFirewall_basic(t *testing.T) {
 	t.Parallel()
 
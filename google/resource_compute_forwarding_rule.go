@@ -32,9 +32,10 @@ func resourceComputeForwardingRule() *schema.Resource {
 			},
 
 			"backend_service": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
 
 			"description": &schema.Schema{
@@ -108,10 +109,11 @@ func resourceComputeForwardingRule() *schema.Resource {
 			},
 
 			"subnetwork": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
 		},
 	}
@@ -125,6 +127,16 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	subnetwork, err := ParseSubnetworkFieldValue(d.Get("subnetwork").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	backendService, err := ParseRegionBackendServiceFieldValue(d.Get("backend_service").(string), d, config)
+	if err != nil {
+		return err
+	}
+
 	region, err := getRegion(d, config)
 	if err != nil {
 		return err
@@ -142,7 +154,7 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 	}
 
 	frule := &compute.ForwardingRule{
-		BackendService:      d.Get("backend_service").(string),
+		BackendService:      backendService.RelativeLink(),
 		IPAddress:           d.Get("ip_address").(string),
 		IPProtocol:          d.Get("ip_protocol").(string),
 		Description:         d.Get("description").(string),
@@ -151,7 +163,7 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 		Network:             network.RelativeLink(),
 		PortRange:           d.Get("port_range").(string),
 		Ports:               ports,
-		Subnetwork:          d.Get("subnetwork").(string),
+		Subnetwork:          subnetwork.RelativeLink(),
 		Target:              d.Get("target").(string),
 	}
 
@@ -7,8 +7,43 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_forwarding_rule", &resource.Sweeper{
+		Name: "gcp_compute_forwarding_rule",
+		F:    testSweepComputeForwardingRules,
+	})
+}
+
+func testSweepComputeForwardingRules(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.ForwardingRules.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing forwarding rules: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "forwarding rule", name, func() (*compute.Operation, error) {
+				return config.clientCompute.ForwardingRules.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeForwardingRule_basic(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_global_address", &resource.Sweeper{
+		Name:         "gcp_compute_global_address",
+		Dependencies: []string{"gcp_compute_global_forwarding_rule"},
+		F:            testSweepComputeGlobalAddresses,
+	})
+}
+
+func testSweepComputeGlobalAddresses(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.GlobalAddresses.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing global addresses: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "global address", name, func() (*compute.Operation, error) {
+				return config.clientCompute.GlobalAddresses.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeGlobalAddress_basic(t *testing.T) {
 	t.Parallel()
 
@@ -9,8 +9,43 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 
 	computeBeta "google.golang.org/api/compute/v0.beta"
+	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_global_forwarding_rule", &resource.Sweeper{
+		Name: "gcp_compute_global_forwarding_rule",
+		F:    testSweepComputeGlobalForwardingRules,
+	})
+}
+
+func testSweepComputeGlobalForwardingRules(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.GlobalForwardingRules.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing global forwarding rules: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "global forwarding rule", name, func() (*compute.Operation, error) {
+				return config.clientCompute.GlobalForwardingRules.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeGlobalForwardingRule_basic(t *testing.T) {
 	t.Parallel()
 
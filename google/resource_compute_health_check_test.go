@@ -11,6 +11,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_health_check", &resource.Sweeper{
+		Name:         "gcp_compute_health_check",
+		Dependencies: []string{"gcp_compute_backend_service", "gcp_compute_region_backend_service"},
+		F:            testSweepComputeHealthChecks,
+	})
+}
+
+func testSweepComputeHealthChecks(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.HealthChecks.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing health checks: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "health check", name, func() (*compute.Operation, error) {
+				return config.clientCompute.HealthChecks.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeHealthCheck_tcp(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_http_health_check", &resource.Sweeper{
+		Name:         "gcp_compute_http_health_check",
+		Dependencies: []string{"gcp_compute_backend_service", "gcp_compute_target_pool"},
+		F:            testSweepComputeHttpHealthChecks,
+	})
+}
+
+func testSweepComputeHttpHealthChecks(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.HttpHealthChecks.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing HTTP health checks: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "HTTP health check", name, func() (*compute.Operation, error) {
+				return config.clientCompute.HttpHealthChecks.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeHttpHealthCheck_basic(t *testing.T) {
 	t.Parallel()
 
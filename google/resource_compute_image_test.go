@@ -10,6 +10,40 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_image", &resource.Sweeper{
+		Name: "gcp_compute_image",
+		F:    testSweepComputeImages,
+	})
+}
+
+func testSweepComputeImages(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Images.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing images: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "image", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Images.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeImage_basic(t *testing.T) {
 	t.Parallel()
 
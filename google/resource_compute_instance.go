@@ -653,10 +653,13 @@ func resourceComputeInstanceCreate(d *schema.ResourceData, meta interface{}) err
 					networkName, err)
 			}
 		} else {
-			subnetworkLink, err = getSubnetworkLink(d, config, prefix+".subnetwork", prefix+".subnetwork_project", "zone")
+			subnetwork, err := parseRegionalFieldValue("subnetworks", subnetworkName, prefix+".subnetwork_project", "", "zone", d, config, true)
 			if err != nil {
-				return err
+				return fmt.Errorf(
+					"Error referencing subnetwork '%s': %s",
+					subnetworkName, err)
 			}
+			subnetworkLink = subnetwork.RelativeLink()
 		}
 
 		// Build the networkInterface
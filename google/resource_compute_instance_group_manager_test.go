@@ -16,6 +16,52 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_instance_group_manager", &resource.Sweeper{
+		Name: "gcp_compute_instance_group_manager",
+		Dependencies: []string{
+			"gcp_compute_autoscaler",
+			"gcp_compute_backend_service",
+			"gcp_compute_region_backend_service",
+		},
+		F: testSweepComputeInstanceGroupManagers,
+	})
+}
+
+func testSweepComputeInstanceGroupManagers(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	zones, err := getSweeperZones(config, region)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range zones {
+		token := ""
+		for paginate := true; paginate; {
+			found, err := config.clientCompute.InstanceGroupManagers.List(config.Project, zone).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("error listing instance group managers in zone %s: %s", zone, err)
+			}
+
+			for _, item := range found.Items {
+				name := item.Name
+				sweepComputeResource(config, "instance group manager", name, func() (*compute.Operation, error) {
+					return config.clientCompute.InstanceGroupManagers.Delete(config.Project, zone, name).Do()
+				})
+			}
+
+			token = found.NextPageToken
+			paginate = token != ""
+		}
+	}
+
+	return nil
+}
+
 func TestAccInstanceGroupManager_basic(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,48 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_instance_group", &resource.Sweeper{
+		Name:         "gcp_compute_instance_group",
+		Dependencies: []string{"gcp_compute_backend_service", "gcp_compute_region_backend_service"},
+		F:            testSweepComputeInstanceGroups,
+	})
+}
+
+func testSweepComputeInstanceGroups(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	zones, err := getSweeperZones(config, region)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range zones {
+		token := ""
+		for paginate := true; paginate; {
+			found, err := config.clientCompute.InstanceGroups.List(config.Project, zone).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("error listing instance groups in zone %s: %s", zone, err)
+			}
+
+			for _, item := range found.Items {
+				name := item.Name
+				sweepComputeResource(config, "instance group", name, func() (*compute.Operation, error) {
+					return config.clientCompute.InstanceGroups.Delete(config.Project, zone, name).Do()
+				})
+			}
+
+			token = found.NextPageToken
+			paginate = token != ""
+		}
+	}
+
+	return nil
+}
+
 func TestAccComputeInstanceGroup_basic(t *testing.T) {
 	t.Parallel()
 
@@ -198,9 +198,10 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 						},
 
 						"subnetwork": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
 						},
 
 						"subnetwork_project": &schema.Schema{
@@ -417,26 +418,18 @@ func buildNetworks(d *schema.ResourceData, meta interface{}) ([]*compute.Network
 	// Build up the list of networks
 	config := meta.(*Config)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return nil, err
-	}
-
 	networksCount := d.Get("network_interface.#").(int)
 	networkInterfaces := make([]*compute.NetworkInterface, 0, networksCount)
 	for i := 0; i < networksCount; i++ {
 		prefix := fmt.Sprintf("network_interface.%d", i)
 
-		var networkName, subnetworkName, subnetworkProject string
+		var networkName, subnetworkName string
 		if v, ok := d.GetOk(prefix + ".network"); ok {
 			networkName = v.(string)
 		}
 		if v, ok := d.GetOk(prefix + ".subnetwork"); ok {
 			subnetworkName = v.(string)
 		}
-		if v, ok := d.GetOk(prefix + ".subnetwork_project"); ok {
-			subnetworkProject = v.(string)
-		}
 		if networkName == "" && subnetworkName == "" {
 			return nil, fmt.Errorf("network or subnetwork must be provided")
 		}
@@ -446,6 +439,7 @@ func buildNetworks(d *schema.ResourceData, meta interface{}) ([]*compute.Network
 
 		var networkLink, subnetworkLink string
 		if networkName != "" {
+			var err error
 			networkLink, err = getNetworkLink(d, config, prefix+".network")
 			if err != nil {
 				return nil, fmt.Errorf("Error referencing network '%s': %s",
@@ -453,22 +447,12 @@ func buildNetworks(d *schema.ResourceData, meta interface{}) ([]*compute.Network
 			}
 
 		} else {
-			// lookup subnetwork link using region and subnetwork name
-			region, err := getRegion(d, config)
-			if err != nil {
-				return nil, err
-			}
-			if subnetworkProject == "" {
-				subnetworkProject = project
-			}
-			subnetwork, err := config.clientCompute.Subnetworks.Get(
-				subnetworkProject, region, subnetworkName).Do()
+			subnetwork, err := ParseSubnetworkFieldValueWithProjectField(subnetworkName, prefix+".subnetwork_project", d, config)
 			if err != nil {
-				return nil, fmt.Errorf(
-					"Error referencing subnetwork '%s' in region '%s': %s",
-					subnetworkName, region, err)
+				return nil, fmt.Errorf("Error referencing subnetwork '%s': %s",
+					subnetworkName, err)
 			}
-			subnetworkLink = subnetwork.SelfLink
+			subnetworkLink = subnetwork.RelativeLink()
 		}
 
 		// Build the networkInterface
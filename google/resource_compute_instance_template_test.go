@@ -12,6 +12,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_instance_template", &resource.Sweeper{
+		Name:         "gcp_compute_instance_template",
+		Dependencies: []string{"gcp_compute_instance_group_manager", "gcp_compute_region_instance_group_manager"},
+		F:            testSweepComputeInstanceTemplates,
+	})
+}
+
+func testSweepComputeInstanceTemplates(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.InstanceTemplates.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing instance templates: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "instance template", name, func() (*compute.Operation, error) {
+				return config.clientCompute.InstanceTemplates.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeInstanceTemplate_basic(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,53 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_instance", &resource.Sweeper{
+		Name: "gcp_compute_instance",
+		Dependencies: []string{
+			"gcp_compute_instance_group",
+			"gcp_compute_instance_group_manager",
+			"gcp_compute_region_instance_group_manager",
+			"gcp_compute_target_pool",
+		},
+		F: testSweepComputeInstances,
+	})
+}
+
+func testSweepComputeInstances(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	zones, err := getSweeperZones(config, region)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range zones {
+		token := ""
+		for paginate := true; paginate; {
+			found, err := config.clientCompute.Instances.List(config.Project, zone).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("error listing instances in zone %s: %s", zone, err)
+			}
+
+			for _, item := range found.Items {
+				name := item.Name
+				sweepComputeResource(config, "instance", name, func() (*compute.Operation, error) {
+					return config.clientCompute.Instances.Delete(config.Project, zone, name).Do()
+				})
+			}
+
+			token = found.NextPageToken
+			paginate = token != ""
+		}
+	}
+
+	return nil
+}
+
 func TestAccComputeInstance_basic1(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,51 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_network", &resource.Sweeper{
+		Name: "gcp_compute_network",
+		Dependencies: []string{
+			"gcp_compute_subnetwork",
+			"gcp_compute_firewall",
+			"gcp_compute_route",
+			"gcp_compute_router",
+			"gcp_compute_vpn_gateway",
+			"gcp_compute_instance",
+			"gcp_compute_instance_template",
+			"gcp_container_cluster",
+			"gcp_dataproc_cluster",
+		},
+		F: testSweepComputeNetworks,
+	})
+}
+
+func testSweepComputeNetworks(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Networks.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing networks: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "network", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Networks.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeNetwork_basic(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,40 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_region_autoscaler", &resource.Sweeper{
+		Name: "gcp_compute_region_autoscaler",
+		F:    testSweepComputeRegionAutoscalers,
+	})
+}
+
+func testSweepComputeRegionAutoscalers(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.RegionAutoscalers.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing region autoscalers: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "region autoscaler", name, func() (*compute.Operation, error) {
+				return config.clientCompute.RegionAutoscalers.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeRegionAutoscaler_basic(t *testing.T) {
 	var ascaler compute.Autoscaler
 
@@ -10,6 +10,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_region_backend_service", &resource.Sweeper{
+		Name:         "gcp_compute_region_backend_service",
+		Dependencies: []string{"gcp_compute_forwarding_rule"},
+		F:            testSweepComputeRegionBackendServices,
+	})
+}
+
+func testSweepComputeRegionBackendServices(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.RegionBackendServices.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing region backend services: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "region backend service", name, func() (*compute.Operation, error) {
+				return config.clientCompute.RegionBackendServices.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeRegionBackendService_basic(t *testing.T) {
 	t.Parallel()
 
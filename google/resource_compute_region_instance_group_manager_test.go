@@ -16,6 +16,41 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_region_instance_group_manager", &resource.Sweeper{
+		Name:         "gcp_compute_region_instance_group_manager",
+		Dependencies: []string{"gcp_compute_region_autoscaler", "gcp_compute_region_backend_service"},
+		F:            testSweepComputeRegionInstanceGroupManagers,
+	})
+}
+
+func testSweepComputeRegionInstanceGroupManagers(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.RegionInstanceGroupManagers.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing region instance group managers: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "region instance group manager", name, func() (*compute.Operation, error) {
+				return config.clientCompute.RegionInstanceGroupManagers.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccRegionInstanceGroupManager_basic(t *testing.T) {
 	t.Parallel()
 
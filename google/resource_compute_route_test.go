@@ -10,6 +10,40 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_route", &resource.Sweeper{
+		Name: "gcp_compute_route",
+		F:    testSweepComputeRoutes,
+	})
+}
+
+func testSweepComputeRoutes(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Routes.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing routes: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "route", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Routes.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeRoute_basic(t *testing.T) {
 	t.Parallel()
 
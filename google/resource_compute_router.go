@@ -230,22 +230,6 @@ func resourceComputeRouterImportState(d *schema.ResourceData, meta interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
-func getRouterLink(config *Config, project string, region string, router string) (string, error) {
-
-	if !strings.HasPrefix(router, "https://www.googleapis.com/compute/") {
-		// Router value provided is just the name, lookup the router SelfLink
-		routerData, err := config.clientCompute.Routers.Get(
-			project, region, router).Do()
-		if err != nil {
-			return "", fmt.Errorf("Error reading router: %s", err)
-		}
-		router = routerData.SelfLink
-	}
-
-	return router, nil
-
-}
-
 func flattenAsn(asn int64) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 	r := make(map[string]interface{})
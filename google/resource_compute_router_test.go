@@ -7,8 +7,44 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_router", &resource.Sweeper{
+		Name:         "gcp_compute_router",
+		Dependencies: []string{"gcp_compute_vpn_tunnel"},
+		F:            testSweepComputeRouters,
+	})
+}
+
+func testSweepComputeRouters(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Routers.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing routers: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "router", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Routers.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeRouter_basic(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,40 @@ import (
 	"google.golang.org/api/googleapi"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_snapshot", &resource.Sweeper{
+		Name: "gcp_compute_snapshot",
+		F:    testSweepComputeSnapshots,
+	})
+}
+
+func testSweepComputeSnapshots(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Snapshots.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing snapshots: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "snapshot", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Snapshots.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeSnapshot_basic(t *testing.T) {
 	t.Parallel()
 
@@ -7,8 +7,44 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_ssl_certificate", &resource.Sweeper{
+		Name:         "gcp_compute_ssl_certificate",
+		Dependencies: []string{"gcp_compute_target_https_proxy", "gcp_compute_target_ssl_proxy"},
+		F:            testSweepComputeSslCertificates,
+	})
+}
+
+func testSweepComputeSslCertificates(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.SslCertificates.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing SSL certificates: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "SSL certificate", name, func() (*compute.Operation, error) {
+				return config.clientCompute.SslCertificates.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeSslCertificate_basic(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,50 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_subnetwork", &resource.Sweeper{
+		Name: "gcp_compute_subnetwork",
+		Dependencies: []string{
+			"gcp_compute_instance",
+			"gcp_compute_instance_template",
+			"gcp_compute_forwarding_rule",
+			"gcp_compute_address",
+			"gcp_compute_region_backend_service",
+			"gcp_compute_vpn_tunnel",
+			"gcp_container_cluster",
+			"gcp_dataproc_cluster",
+		},
+		F: testSweepComputeSubnetworks,
+	})
+}
+
+func testSweepComputeSubnetworks(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.Subnetworks.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing subnetworks: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "subnetwork", name, func() (*compute.Operation, error) {
+				return config.clientCompute.Subnetworks.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeSubnetwork_basic(t *testing.T) {
 	t.Parallel()
 
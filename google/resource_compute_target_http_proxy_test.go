@@ -7,8 +7,44 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_target_http_proxy", &resource.Sweeper{
+		Name:         "gcp_compute_target_http_proxy",
+		Dependencies: []string{"gcp_compute_global_forwarding_rule"},
+		F:            testSweepComputeTargetHttpProxies,
+	})
+}
+
+func testSweepComputeTargetHttpProxies(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.TargetHttpProxies.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing target HTTP proxies: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "target HTTP proxy", name, func() (*compute.Operation, error) {
+				return config.clientCompute.TargetHttpProxies.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeTargetHttpProxy_basic(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,41 @@ import (
 	"regexp"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_target_https_proxy", &resource.Sweeper{
+		Name:         "gcp_compute_target_https_proxy",
+		Dependencies: []string{"gcp_compute_global_forwarding_rule"},
+		F:            testSweepComputeTargetHttpsProxies,
+	})
+}
+
+func testSweepComputeTargetHttpsProxies(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.TargetHttpsProxies.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing target HTTPS proxies: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "target HTTPS proxy", name, func() (*compute.Operation, error) {
+				return config.clientCompute.TargetHttpsProxies.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeTargetHttpsProxy_basic(t *testing.T) {
 	t.Parallel()
 
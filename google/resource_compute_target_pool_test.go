@@ -7,8 +7,48 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_target_pool", &resource.Sweeper{
+		Name: "gcp_compute_target_pool",
+		Dependencies: []string{
+			"gcp_compute_forwarding_rule",
+			"gcp_compute_instance_group_manager",
+			"gcp_compute_region_instance_group_manager",
+		},
+		F: testSweepComputeTargetPools,
+	})
+}
+
+func testSweepComputeTargetPools(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.TargetPools.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing target pools: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "target pool", name, func() (*compute.Operation, error) {
+				return config.clientCompute.TargetPools.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeTargetPool_basic(t *testing.T) {
 	t.Parallel()
 
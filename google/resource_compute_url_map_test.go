@@ -7,8 +7,44 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_url_map", &resource.Sweeper{
+		Name:         "gcp_compute_url_map",
+		Dependencies: []string{"gcp_compute_target_http_proxy", "gcp_compute_target_https_proxy"},
+		F:            testSweepComputeUrlMaps,
+	})
+}
+
+func testSweepComputeUrlMaps(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.UrlMaps.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing URL maps: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "URL map", name, func() (*compute.Operation, error) {
+				return config.clientCompute.UrlMaps.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeUrlMap_basic(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,41 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_vpn_gateway", &resource.Sweeper{
+		Name:         "gcp_compute_vpn_gateway",
+		Dependencies: []string{"gcp_compute_vpn_tunnel", "gcp_compute_forwarding_rule"},
+		F:            testSweepComputeVpnGateways,
+	})
+}
+
+func testSweepComputeVpnGateways(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.TargetVpnGateways.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing VPN gatewaies: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "VPN gateway", name, func() (*compute.Operation, error) {
+				return config.clientCompute.TargetVpnGateways.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeVpnGateway_basic(t *testing.T) {
 	t.Parallel()
 
@@ -124,13 +124,22 @@ func resourceComputeVpnTunnelCreate(d *schema.ResourceData, meta interface{}) er
 	name := d.Get("name").(string)
 	peerIp := d.Get("peer_ip").(string)
 	sharedSecret := d.Get("shared_secret").(string)
-	targetVpnGateway := d.Get("target_vpn_gateway").(string)
 	ikeVersion := d.Get("ike_version").(int)
 
 	if ikeVersion < 1 || ikeVersion > 2 {
 		return fmt.Errorf("Only IKE version 1 or 2 supported, not %d", ikeVersion)
 	}
 
+	targetVpnGateway, err := ParseVpnGatewayFieldValue(d.Get("target_vpn_gateway").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	router, err := ParseRouterFieldValue(d.Get("router").(string), d, config)
+	if err != nil {
+		return err
+	}
+
 	// Build up the list of sources
 	var localTrafficSelectors []string
 	if v := d.Get("local_traffic_selector").(*schema.Set); v.Len() > 0 {
@@ -154,7 +163,8 @@ func resourceComputeVpnTunnelCreate(d *schema.ResourceData, meta interface{}) er
 		Name:                  name,
 		PeerIp:                peerIp,
 		SharedSecret:          sharedSecret,
-		TargetVpnGateway:      targetVpnGateway,
+		TargetVpnGateway:      targetVpnGateway.RelativeLink(),
+		Router:                router.RelativeLink(),
 		IkeVersion:            int64(ikeVersion),
 		LocalTrafficSelector:  localTrafficSelectors,
 		RemoteTrafficSelector: remoteTrafficSelectors,
@@ -164,14 +174,6 @@ func resourceComputeVpnTunnelCreate(d *schema.ResourceData, meta interface{}) er
 		vpnTunnel.Description = v.(string)
 	}
 
-	if v, ok := d.GetOk("router"); ok {
-		routerLink, err := getRouterLink(config, project, region, v.(string))
-		if err != nil {
-			return err
-		}
-		vpnTunnel.Router = routerLink
-	}
-
 	op, err := vpnTunnelsService.Insert(project, region, vpnTunnel).Do()
 	if err != nil {
 		return fmt.Errorf("Error Inserting VPN Tunnel %s : %s", name, err)
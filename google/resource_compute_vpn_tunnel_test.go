@@ -11,6 +11,40 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_compute_vpn_tunnel", &resource.Sweeper{
+		Name: "gcp_compute_vpn_tunnel",
+		F:    testSweepComputeVpnTunnels,
+	})
+}
+
+func testSweepComputeVpnTunnels(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientCompute.VpnTunnels.List(config.Project, region).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing VPN tunnels: %s", err)
+		}
+
+		for _, item := range found.Items {
+			name := item.Name
+			sweepComputeResource(config, "VPN tunnel", name, func() (*compute.Operation, error) {
+				return config.clientCompute.VpnTunnels.Delete(config.Project, region, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccComputeVpnTunnel_basic(t *testing.T) {
 	t.Parallel()
 
@@ -12,12 +12,37 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/container/v1"
+	containerBeta "google.golang.org/api/container/v1beta1"
 )
 
 var (
 	instanceGroupManagerURL = regexp.MustCompile("^https://www.googleapis.com/compute/v1/projects/([a-z][a-z0-9-]{5}(?:[-a-z0-9]{0,23}[a-z0-9])?)/zones/([a-z0-9-]*)/instanceGroupManagers/([^/]*)")
 )
 
+var ContainerClusterBaseApiVersion = v1
+var ContainerClusterVersionedFeatures = []Feature{
+	{
+		Version: v1beta1,
+		Item:    "pod_security_policy_config",
+	},
+	{
+		Version: v1beta1,
+		Item:    "node_config.*.taint",
+	},
+	{
+		Version: v1beta1,
+		Item:    "node_pool.*.node_config.*.taint",
+	},
+	{
+		Version: v1beta1,
+		Item:    "node_config.*.workload_metadata_config",
+	},
+	{
+		Version: v1beta1,
+		Item:    "node_pool.*.node_config.*.workload_metadata_config",
+	},
+}
+
 func resourceContainerCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceContainerClusterCreate,
@@ -67,9 +92,17 @@ func resourceContainerCluster() *schema.Resource {
 			},
 
 			"zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"region"},
+			},
+
+			"region": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"zone"},
 			},
 
 			"additional_zones": {
@@ -128,6 +161,20 @@ func resourceContainerCluster() *schema.Resource {
 								},
 							},
 						},
+						"network_policy_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -165,6 +212,56 @@ func resourceContainerCluster() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"ip_allocation_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_secondary_range_name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"ip_allocation_policy.0.cluster_ipv4_cidr_block", "ip_allocation_policy.0.create_subnetwork"},
+						},
+						"services_secondary_range_name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"ip_allocation_policy.0.services_ipv4_cidr_block", "ip_allocation_policy.0.create_subnetwork"},
+						},
+						"cluster_ipv4_cidr_block": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: ipCidrRangeDiffSuppress,
+						},
+						"services_ipv4_cidr_block": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: ipCidrRangeDiffSuppress,
+						},
+						"create_subnetwork": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"subnetwork_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"logging_service": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -172,6 +269,34 @@ func resourceContainerCluster() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"logging.googleapis.com", "none"}, false),
 			},
 
+			"maintenance_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_maintenance_window": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateRFC3339Time,
+									},
+									"duration": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"master_auth": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -260,6 +385,29 @@ func resourceContainerCluster() *schema.Resource {
 				StateFunc: StoreResourceName,
 			},
 
+			"network_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"provider": {
+							Type:             schema.TypeString,
+							Default:          "PROVIDER_UNSPECIFIED",
+							Optional:         true,
+							ValidateFunc:     validation.StringInSlice([]string{"PROVIDER_UNSPECIFIED", "CALICO"}, false),
+							DiffSuppressFunc: emptyOrDefaultStringSuppress("PROVIDER_UNSPECIFIED"),
+						},
+					},
+				},
+			},
+
 			"node_config": schemaNodeConfig,
 
 			"node_pool": {
@@ -278,6 +426,55 @@ func resourceContainerCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"pod_security_policy_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"private_cluster_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_private_endpoint": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"enable_private_nodes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"master_ipv4_cidr_block": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.CIDRNetwork(28, 28),
+						},
+						"private_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -287,6 +484,7 @@ func resourceContainerCluster() *schema.Resource {
 			"subnetwork": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -310,6 +508,7 @@ func resourceContainerCluster() *schema.Resource {
 }
 
 func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	containerApiVersion := getContainerApiVersion(d, ContainerClusterBaseApiVersion, ContainerClusterVersionedFeatures)
 	config := meta.(*Config)
 
 	project, err := getProject(d, config)
@@ -317,10 +516,13 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	zoneName := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
 	clusterName := d.Get("name").(string)
 
-	cluster := &container.Cluster{
+	cluster := &containerBeta.Cluster{
 		Name:             clusterName,
 		InitialNodeCount: int64(d.Get("initial_node_count").(int)),
 	}
@@ -330,7 +532,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 	if v, ok := d.GetOk("master_auth"); ok {
 		masterAuths := v.([]interface{})
 		masterAuth := masterAuths[0].(map[string]interface{})
-		cluster.MasterAuth = &container.MasterAuth{
+		cluster.MasterAuth = &containerBeta.MasterAuth{
 			Password: masterAuth["password"].(string),
 			Username: masterAuth["username"].(string),
 		}
@@ -344,6 +546,10 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		cluster.InitialClusterVersion = v.(string)
 	}
 
+	if v, ok := d.GetOk("private_cluster_config"); ok {
+		cluster.PrivateClusterConfig = expandPrivateClusterConfig(v)
+	}
+
 	// Only allow setting node_version on create if it's set to the equivalent master version,
 	// since `InitialClusterVersion` only accepts valid master-style versions.
 	if v, ok := d.GetOk("node_version"); ok {
@@ -356,16 +562,15 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if v, ok := d.GetOk("additional_zones"); ok {
-		locationsList := v.(*schema.Set).List()
-		locations := []string{}
-		for _, v := range locationsList {
-			location := v.(string)
+		locationsSet := v.(*schema.Set)
+		if locationsSet.Contains(location) {
+			return fmt.Errorf("additional_zones should not contain the original 'zone'.")
+		}
+		locations := convertStringSet(locationsSet)
+		// Regional clusters list every node zone in additional_zones.
+		if isZone(location) {
 			locations = append(locations, location)
-			if location == zoneName {
-				return fmt.Errorf("additional_zones should not contain the original 'zone'.")
-			}
 		}
-		locations = append(locations, zoneName)
 		cluster.Locations = locations
 	}
 
@@ -377,7 +582,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		cluster.Description = v.(string)
 	}
 
-	cluster.LegacyAbac = &container.LegacyAbac{
+	cluster.LegacyAbac = &containerBeta.LegacyAbac{
 		Enabled:         d.Get("enable_legacy_abac").(bool),
 		ForceSendFields: []string{"Enabled"},
 	}
@@ -406,6 +611,18 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		cluster.AddonsConfig = expandClusterAddonsConfig(v)
 	}
 
+	if v, ok := d.GetOk("ip_allocation_policy"); ok {
+		cluster.IpAllocationPolicy = expandIPAllocationPolicy(v)
+	}
+
+	if v, ok := d.GetOk("network_policy"); ok && len(v.([]interface{})) > 0 {
+		cluster.NetworkPolicy = expandNetworkPolicy(v)
+	}
+
+	if v, ok := d.GetOk("maintenance_policy"); ok {
+		cluster.MaintenancePolicy = expandMaintenancePolicy(v)
+	}
+
 	if v, ok := d.GetOk("node_config"); ok {
 		cluster.NodeConfig = expandNodeConfig(v)
 	}
@@ -416,7 +633,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 
 	nodePoolsCount := d.Get("node_pool.#").(int)
 	if nodePoolsCount > 0 {
-		nodePools := make([]*container.NodePool, 0, nodePoolsCount)
+		nodePools := make([]*containerBeta.NodePool, 0, nodePoolsCount)
 		for i := 0; i < nodePoolsCount; i++ {
 			prefix := fmt.Sprintf("node_pool.%d.", i)
 			nodePool, err := expandNodePool(d, prefix)
@@ -428,18 +645,35 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		cluster.NodePools = nodePools
 	}
 
-	req := &container.CreateClusterRequest{
+	if v, ok := d.GetOk("pod_security_policy_config"); ok {
+		cluster.PodSecurityPolicyConfig = expandPodSecurityPolicyConfig(v)
+	}
+
+	req := &containerBeta.CreateClusterRequest{
 		Cluster: cluster,
 	}
 
-	op, err := config.clientContainer.Projects.Zones.Clusters.Create(
-		project, zoneName, req).Do()
+	var op interface{}
+	switch containerApiVersion {
+	case v1:
+		reqV1 := &container.CreateClusterRequest{}
+		err = Convert(req, reqV1)
+		if err != nil {
+			return err
+		}
+
+		op, err = config.clientContainer.Projects.Locations.Clusters.Create(
+			containerLocationName(project, location), reqV1).Do()
+	case v1beta1:
+		op, err = config.clientContainerBeta.Projects.Locations.Clusters.Create(
+			containerLocationName(project, location), req).Do()
+	}
 	if err != nil {
 		return err
 	}
 
 	// Wait until it's created
-	waitErr := containerOperationWait(config, op, project, zoneName, "creating GKE cluster", timeoutInMinutes, 3)
+	waitErr := containerSharedOperationWait(config, op, project, location, "creating GKE cluster", timeoutInMinutes, 3)
 	if waitErr != nil {
 		// The resource didn't actually create
 		d.SetId("")
@@ -454,6 +688,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+	containerApiVersion := getContainerApiVersion(d, ContainerClusterBaseApiVersion, ContainerClusterVersionedFeatures)
 	config := meta.(*Config)
 
 	project, err := getProject(d, config)
@@ -461,12 +696,14 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	zoneName := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
 
-	var cluster *container.Cluster
+	var cluster *containerBeta.Cluster
 	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		cluster, err = config.clientContainer.Projects.Zones.Clusters.Get(
-			project, zoneName, d.Get("name").(string)).Do()
+		cluster, err = getContainerCluster(config, containerApiVersion, project, location, d.Get("name").(string))
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -480,14 +717,18 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("name", cluster.Name)
-	d.Set("zone", cluster.Zone)
+	if isZone(cluster.Location) {
+		d.Set("zone", cluster.Location)
+	} else {
+		d.Set("region", cluster.Location)
+	}
 
+	// Only additional zones are stored, so drop the cluster's own zone. Regional
+	// clusters have no zone of their own, so every node zone is kept.
 	locations := []string{}
-	if len(cluster.Locations) > 1 {
-		for _, location := range cluster.Locations {
-			if location != cluster.Zone {
-				locations = append(locations, location)
-			}
+	for _, l := range cluster.Locations {
+		if l != cluster.Location {
+			locations = append(locations, l)
 		}
 	}
 	d.Set("additional_zones", locations)
@@ -524,6 +765,18 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	if cluster.AddonsConfig != nil {
 		d.Set("addons_config", flattenClusterAddonsConfig(cluster.AddonsConfig))
 	}
+	if err := d.Set("ip_allocation_policy", flattenIPAllocationPolicy(cluster.IpAllocationPolicy)); err != nil {
+		return err
+	}
+	if err := d.Set("private_cluster_config", flattenPrivateClusterConfig(cluster.PrivateClusterConfig)); err != nil {
+		return err
+	}
+	if err := d.Set("network_policy", flattenNetworkPolicy(cluster.NetworkPolicy)); err != nil {
+		return err
+	}
+	if err := d.Set("maintenance_policy", flattenMaintenancePolicy(cluster.MaintenancePolicy)); err != nil {
+		return err
+	}
 	nps, err := flattenClusterNodePools(d, config, cluster.NodePools)
 	if err != nil {
 		return err
@@ -536,6 +789,10 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("instance_group_urls", igUrls)
 	}
 
+	if cluster.PodSecurityPolicyConfig != nil {
+		d.Set("pod_security_policy_config", flattenPodSecurityPolicyConfig(cluster.PodSecurityPolicyConfig))
+	}
+
 	return nil
 }
 
@@ -547,7 +804,10 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	zoneName := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
 	clusterName := d.Get("name").(string)
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutUpdate).Minutes())
 
@@ -555,19 +815,18 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 
 	if d.HasChange("master_authorized_networks_config") {
 		c := d.Get("master_authorized_networks_config")
-		req := &container.UpdateClusterRequest{
-			Update: &container.ClusterUpdate{
+		req := &containerBeta.UpdateClusterRequest{
+			Update: &containerBeta.ClusterUpdate{
 				DesiredMasterAuthorizedNetworksConfig: expandMasterAuthorizedNetworksConfig(c),
 			},
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.Update(
-			project, zoneName, clusterName, req).Do()
+		op, err := updateContainerCluster(d, config, project, location, clusterName, req)
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE cluster master authorized networks", timeoutInMinutes, 2)
+		waitErr := containerSharedOperationWait(config, op, project, location, "updating GKE cluster master authorized networks", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -591,19 +850,18 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 
 		// Only upgrade the master if the current version is lower than the desired version
 		if cur.LessThan(des) {
-			req := &container.UpdateClusterRequest{
-				Update: &container.ClusterUpdate{
+			req := &containerBeta.UpdateClusterRequest{
+				Update: &containerBeta.ClusterUpdate{
 					DesiredMasterVersion: desiredMasterVersion,
 				},
 			}
-			op, err := config.clientContainer.Projects.Zones.Clusters.Update(
-				project, zoneName, clusterName, req).Do()
+			op, err := updateContainerCluster(d, config, project, location, clusterName, req)
 			if err != nil {
 				return err
 			}
 
 			// Wait until it's updated
-			waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE master version", timeoutInMinutes, 2)
+			waitErr := containerSharedOperationWait(config, op, project, location, "updating GKE master version", timeoutInMinutes, 2)
 			if waitErr != nil {
 				return waitErr
 			}
@@ -618,19 +876,18 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("node_version") {
 		desiredNodeVersion := d.Get("node_version").(string)
 
-		req := &container.UpdateClusterRequest{
-			Update: &container.ClusterUpdate{
+		req := &containerBeta.UpdateClusterRequest{
+			Update: &containerBeta.ClusterUpdate{
 				DesiredNodeVersion: desiredNodeVersion,
 			},
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.Update(
-			project, zoneName, clusterName, req).Do()
+		op, err := updateContainerCluster(d, config, project, location, clusterName, req)
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE node version", timeoutInMinutes, 2)
+		waitErr := containerSharedOperationWait(config, op, project, location, "updating GKE node version", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -643,19 +900,18 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 
 	if d.HasChange("addons_config") {
 		if ac, ok := d.GetOk("addons_config"); ok {
-			req := &container.UpdateClusterRequest{
-				Update: &container.ClusterUpdate{
+			req := &containerBeta.UpdateClusterRequest{
+				Update: &containerBeta.ClusterUpdate{
 					DesiredAddonsConfig: expandClusterAddonsConfig(ac),
 				},
 			}
-			op, err := config.clientContainer.Projects.Zones.Clusters.Update(
-				project, zoneName, clusterName, req).Do()
+			op, err := updateContainerCluster(d, config, project, location, clusterName, req)
 			if err != nil {
 				return err
 			}
 
 			// Wait until it's updated
-			waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE cluster addons", timeoutInMinutes, 2)
+			waitErr := containerSharedOperationWait(config, op, project, location, "updating GKE cluster addons", timeoutInMinutes, 2)
 			if waitErr != nil {
 				return waitErr
 			}
@@ -666,26 +922,117 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("maintenance_policy") {
+		var req *container.SetMaintenancePolicyRequest
+		if mp, ok := d.GetOk("maintenance_policy"); ok {
+			maintenancePolicy := &container.MaintenancePolicy{}
+			err := Convert(expandMaintenancePolicy(mp), maintenancePolicy)
+			if err != nil {
+				return err
+			}
+			req = &container.SetMaintenancePolicyRequest{
+				MaintenancePolicy: maintenancePolicy,
+			}
+		} else {
+			req = &container.SetMaintenancePolicyRequest{
+				NullFields: []string{"MaintenancePolicy"},
+			}
+		}
+
+		op, err := config.clientContainer.Projects.Locations.Clusters.SetMaintenancePolicy(
+			containerClusterFullName(project, location, clusterName), req).Do()
+		if err != nil {
+			return err
+		}
+
+		// Wait until it's updated
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE cluster maintenance policy", timeoutInMinutes, 2)
+		if waitErr != nil {
+			return waitErr
+		}
+
+		log.Printf("[INFO] GKE cluster %s maintenance policy has been updated", d.Id())
+
+		d.SetPartial("maintenance_policy")
+	}
+
+	if d.HasChange("network_policy") {
+		networkPolicy := expandNetworkPolicy(d.Get("network_policy"))
+
+		// Network policy enforcement can only be turned on once the network policy
+		// addon is enabled, which has to happen in a separate operation.
+		if networkPolicy.Enabled {
+			req := &containerBeta.UpdateClusterRequest{
+				Update: &containerBeta.ClusterUpdate{
+					DesiredAddonsConfig: &containerBeta.AddonsConfig{
+						NetworkPolicyConfig: &containerBeta.NetworkPolicyConfig{
+							Disabled:        false,
+							ForceSendFields: []string{"Disabled"},
+						},
+					},
+				},
+			}
+			op, err := updateContainerCluster(d, config, project, location, clusterName, req)
+			if err != nil {
+				return err
+			}
+
+			// Wait until it's updated
+			waitErr := containerSharedOperationWait(config, op, project, location, "enabling GKE network policy addon", timeoutInMinutes, 2)
+			if waitErr != nil {
+				return waitErr
+			}
+
+			log.Printf("[INFO] GKE cluster %s network policy addon has been enabled", d.Id())
+		}
+
+		networkPolicyV1 := &container.NetworkPolicy{}
+		err := Convert(networkPolicy, networkPolicyV1)
+		if err != nil {
+			return err
+		}
+		networkPolicyV1.ForceSendFields = []string{"Enabled"}
+		req := &container.SetNetworkPolicyRequest{
+			NetworkPolicy: networkPolicyV1,
+		}
+		op, err := config.clientContainer.Projects.Locations.Clusters.SetNetworkPolicy(
+			containerClusterFullName(project, location, clusterName), req).Do()
+		if err != nil {
+			return err
+		}
+
+		// Wait until it's updated
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE cluster network policy", timeoutInMinutes, 2)
+		if waitErr != nil {
+			return waitErr
+		}
+
+		log.Printf("[INFO] GKE cluster %s network policy has been updated to %v", d.Id(), networkPolicy.Enabled)
+
+		d.SetPartial("network_policy")
+	}
+
 	if d.HasChange("additional_zones") {
 		azSet := d.Get("additional_zones").(*schema.Set)
-		if azSet.Contains(zoneName) {
+		if azSet.Contains(location) {
 			return fmt.Errorf("additional_zones should not contain the original 'zone'.")
 		}
-		azs := convertStringArr(azSet.List())
-		locations := append(azs, zoneName)
-		req := &container.UpdateClusterRequest{
-			Update: &container.ClusterUpdate{
+		locations := convertStringArr(azSet.List())
+		if isZone(location) {
+			locations = append(locations, location)
+		}
+		req := &containerBeta.UpdateClusterRequest{
+			Update: &containerBeta.ClusterUpdate{
 				DesiredLocations: locations,
 			},
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.Update(
-			project, zoneName, clusterName, req).Do()
+		op, err := updateContainerCluster(d, config, project, location, clusterName, req)
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE cluster locations", timeoutInMinutes, 2)
+		waitErr := containerSharedOperationWait(config, op, project, location, "updating GKE cluster locations", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -702,13 +1049,14 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 			Enabled:         enabled,
 			ForceSendFields: []string{"Enabled"},
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.LegacyAbac(project, zoneName, clusterName, req).Do()
+		op, err := config.clientContainer.Projects.Locations.Clusters.SetLegacyAbac(
+			containerClusterFullName(project, location, clusterName), req).Do()
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE legacy ABAC", timeoutInMinutes, 2)
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE legacy ABAC", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -721,19 +1069,18 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("monitoring_service") {
 		desiredMonitoringService := d.Get("monitoring_service").(string)
 
-		req := &container.UpdateClusterRequest{
-			Update: &container.ClusterUpdate{
+		req := &containerBeta.UpdateClusterRequest{
+			Update: &containerBeta.ClusterUpdate{
 				DesiredMonitoringService: desiredMonitoringService,
 			},
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.Update(
-			project, zoneName, clusterName, req).Do()
+		op, err := updateContainerCluster(d, config, project, location, clusterName, req)
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE cluster monitoring service", timeoutInMinutes, 2)
+		waitErr := containerSharedOperationWait(config, op, project, location, "updating GKE cluster monitoring service", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -743,6 +1090,28 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		d.SetPartial("monitoring_service")
 	}
 
+	if d.HasChange("pod_security_policy_config") {
+		c := d.Get("pod_security_policy_config")
+		req := &containerBeta.UpdateClusterRequest{
+			Update: &containerBeta.ClusterUpdate{
+				DesiredPodSecurityPolicyConfig: expandPodSecurityPolicyConfig(c),
+			},
+		}
+		op, err := updateContainerCluster(d, config, project, location, clusterName, req)
+		if err != nil {
+			return err
+		}
+
+		// Wait until it's updated
+		waitErr := containerSharedOperationWait(config, op, project, location, "updating GKE cluster pod security policy config", timeoutInMinutes, 2)
+		if waitErr != nil {
+			return waitErr
+		}
+		log.Printf("[INFO] GKE cluster %s pod security policy config has been updated", d.Id())
+
+		d.SetPartial("pod_security_policy_config")
+	}
+
 	if n, ok := d.GetOk("node_pool.#"); ok {
 		for i := 0; i < n.(int); i++ {
 			if err := nodePoolUpdate(d, meta, clusterName, fmt.Sprintf("node_pool.%d.", i), timeoutInMinutes); err != nil {
@@ -758,14 +1127,14 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		req := &container.SetLoggingServiceRequest{
 			LoggingService: logging,
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.Logging(
-			project, zoneName, clusterName, req).Do()
+		op, err := config.clientContainer.Projects.Locations.Clusters.SetLogging(
+			containerClusterFullName(project, location, clusterName), req).Do()
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zoneName, "updating GKE logging service", timeoutInMinutes, 2)
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE logging service", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -788,19 +1157,22 @@ func resourceContainerClusterDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	zoneName := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
 	clusterName := d.Get("name").(string)
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutDelete).Minutes())
 
 	log.Printf("[DEBUG] Deleting GKE cluster %s", d.Get("name").(string))
-	op, err := config.clientContainer.Projects.Zones.Clusters.Delete(
-		project, zoneName, clusterName).Do()
+	op, err := config.clientContainer.Projects.Locations.Clusters.Delete(
+		containerClusterFullName(project, location, clusterName)).Do()
 	if err != nil {
 		return err
 	}
 
 	// Wait until it's deleted
-	waitErr := containerOperationWait(config, op, project, zoneName, "deleting GKE cluster", timeoutInMinutes, 3)
+	waitErr := containerOperationWait(config, op, project, location, "deleting GKE cluster", timeoutInMinutes, 3)
 	if waitErr != nil {
 		return waitErr
 	}
@@ -812,6 +1184,60 @@ func resourceContainerClusterDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func getContainerCluster(config *Config, containerApiVersion ApiVersion, project, location, name string) (*containerBeta.Cluster, error) {
+	switch containerApiVersion {
+	case v1:
+		clusterV1, err := config.clientContainer.Projects.Locations.Clusters.Get(
+			containerClusterFullName(project, location, name)).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		cluster := &containerBeta.Cluster{}
+		err = Convert(clusterV1, cluster)
+		if err != nil {
+			return nil, err
+		}
+		return cluster, nil
+	case v1beta1:
+		return config.clientContainerBeta.Projects.Locations.Clusters.Get(
+			containerClusterFullName(project, location, name)).Do()
+	}
+
+	return nil, fmt.Errorf("Unknown Container API version %v", containerApiVersion)
+}
+
+// Sends a cluster update request with the version of the Container API required by the
+// features currently in use by the cluster.
+func updateContainerCluster(d *schema.ResourceData, config *Config, project, location, name string, req *containerBeta.UpdateClusterRequest) (interface{}, error) {
+	containerApiVersion := getContainerApiVersionUpdate(d, ContainerClusterBaseApiVersion, ContainerClusterVersionedFeatures, []Feature{})
+
+	switch containerApiVersion {
+	case v1:
+		reqV1 := &container.UpdateClusterRequest{}
+		err := Convert(req, reqV1)
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := config.clientContainer.Projects.Locations.Clusters.Update(
+			containerClusterFullName(project, location, name), reqV1).Do()
+		if err != nil {
+			return nil, err
+		}
+		return op, nil
+	case v1beta1:
+		op, err := config.clientContainerBeta.Projects.Locations.Clusters.Update(
+			containerClusterFullName(project, location, name), req).Do()
+		if err != nil {
+			return nil, err
+		}
+		return op, nil
+	}
+
+	return nil, fmt.Errorf("Unknown Container API version %v", containerApiVersion)
+}
+
 // container engine's API currently mistakenly returns the instance group manager's
 // URL instead of the instance group's URL in its responses. This shim detects that
 // error, and corrects it, by fetching the instance group manager URL and retrieving
@@ -836,13 +1262,13 @@ func getInstanceGroupUrlsFromManagerUrls(config *Config, igmUrls []string) ([]st
 	return instanceGroupURLs, nil
 }
 
-func expandClusterAddonsConfig(configured interface{}) *container.AddonsConfig {
+func expandClusterAddonsConfig(configured interface{}) *containerBeta.AddonsConfig {
 	config := configured.([]interface{})[0].(map[string]interface{})
-	ac := &container.AddonsConfig{}
+	ac := &containerBeta.AddonsConfig{}
 
 	if v, ok := config["http_load_balancing"]; ok && len(v.([]interface{})) > 0 {
 		addon := v.([]interface{})[0].(map[string]interface{})
-		ac.HttpLoadBalancing = &container.HttpLoadBalancing{
+		ac.HttpLoadBalancing = &containerBeta.HttpLoadBalancing{
 			Disabled:        addon["disabled"].(bool),
 			ForceSendFields: []string{"Disabled"},
 		}
@@ -850,7 +1276,7 @@ func expandClusterAddonsConfig(configured interface{}) *container.AddonsConfig {
 
 	if v, ok := config["horizontal_pod_autoscaling"]; ok && len(v.([]interface{})) > 0 {
 		addon := v.([]interface{})[0].(map[string]interface{})
-		ac.HorizontalPodAutoscaling = &container.HorizontalPodAutoscaling{
+		ac.HorizontalPodAutoscaling = &containerBeta.HorizontalPodAutoscaling{
 			Disabled:        addon["disabled"].(bool),
 			ForceSendFields: []string{"Disabled"},
 		}
@@ -858,7 +1284,15 @@ func expandClusterAddonsConfig(configured interface{}) *container.AddonsConfig {
 
 	if v, ok := config["kubernetes_dashboard"]; ok && len(v.([]interface{})) > 0 {
 		addon := v.([]interface{})[0].(map[string]interface{})
-		ac.KubernetesDashboard = &container.KubernetesDashboard{
+		ac.KubernetesDashboard = &containerBeta.KubernetesDashboard{
+			Disabled:        addon["disabled"].(bool),
+			ForceSendFields: []string{"Disabled"},
+		}
+	}
+
+	if v, ok := config["network_policy_config"]; ok && len(v.([]interface{})) > 0 {
+		addon := v.([]interface{})[0].(map[string]interface{})
+		ac.NetworkPolicyConfig = &containerBeta.NetworkPolicyConfig{
 			Disabled:        addon["disabled"].(bool),
 			ForceSendFields: []string{"Disabled"},
 		}
@@ -866,17 +1300,60 @@ func expandClusterAddonsConfig(configured interface{}) *container.AddonsConfig {
 	return ac
 }
 
-func expandMasterAuthorizedNetworksConfig(configured interface{}) *container.MasterAuthorizedNetworksConfig {
-	result := &container.MasterAuthorizedNetworksConfig{}
+func expandIPAllocationPolicy(configured interface{}) *containerBeta.IPAllocationPolicy {
+	config := configured.([]interface{})[0].(map[string]interface{})
+
+	return &containerBeta.IPAllocationPolicy{
+		UseIpAliases:               true,
+		CreateSubnetwork:           config["create_subnetwork"].(bool),
+		SubnetworkName:             config["subnetwork_name"].(string),
+		ClusterSecondaryRangeName:  config["cluster_secondary_range_name"].(string),
+		ServicesSecondaryRangeName: config["services_secondary_range_name"].(string),
+		ClusterIpv4CidrBlock:       config["cluster_ipv4_cidr_block"].(string),
+		ServicesIpv4CidrBlock:      config["services_ipv4_cidr_block"].(string),
+	}
+}
+
+func expandNetworkPolicy(configured interface{}) *containerBeta.NetworkPolicy {
+	result := &containerBeta.NetworkPolicy{}
+	if configured != nil && len(configured.([]interface{})) > 0 {
+		config := configured.([]interface{})[0].(map[string]interface{})
+		if enabled, ok := config["enabled"]; ok && enabled.(bool) {
+			result.Enabled = true
+			if provider, ok := config["provider"]; ok {
+				result.Provider = provider.(string)
+			}
+		}
+	}
+	return result
+}
+
+func expandMaintenancePolicy(configured interface{}) *containerBeta.MaintenancePolicy {
+	result := &containerBeta.MaintenancePolicy{}
+	if len(configured.([]interface{})) > 0 {
+		maintenancePolicy := configured.([]interface{})[0].(map[string]interface{})
+		dailyMaintenanceWindow := maintenancePolicy["daily_maintenance_window"].([]interface{})[0].(map[string]interface{})
+		startTime := dailyMaintenanceWindow["start_time"].(string)
+		result.Window = &containerBeta.MaintenanceWindow{
+			DailyMaintenanceWindow: &containerBeta.DailyMaintenanceWindow{
+				StartTime: startTime,
+			},
+		}
+	}
+	return result
+}
+
+func expandMasterAuthorizedNetworksConfig(configured interface{}) *containerBeta.MasterAuthorizedNetworksConfig {
+	result := &containerBeta.MasterAuthorizedNetworksConfig{}
 	if len(configured.([]interface{})) > 0 {
 		result.Enabled = true
 		config := configured.([]interface{})[0].(map[string]interface{})
 		if _, ok := config["cidr_blocks"]; ok {
 			cidrBlocks := config["cidr_blocks"].(*schema.Set).List()
-			result.CidrBlocks = make([]*container.CidrBlock, 0)
+			result.CidrBlocks = make([]*containerBeta.CidrBlock, 0)
 			for _, v := range cidrBlocks {
 				cidrBlock := v.(map[string]interface{})
-				result.CidrBlocks = append(result.CidrBlocks, &container.CidrBlock{
+				result.CidrBlocks = append(result.CidrBlocks, &containerBeta.CidrBlock{
 					CidrBlock:   cidrBlock["cidr_block"].(string),
 					DisplayName: cidrBlock["display_name"].(string),
 				})
@@ -886,7 +1363,7 @@ func expandMasterAuthorizedNetworksConfig(configured interface{}) *container.Mas
 	return result
 }
 
-func flattenClusterAddonsConfig(c *container.AddonsConfig) []map[string]interface{} {
+func flattenClusterAddonsConfig(c *containerBeta.AddonsConfig) []map[string]interface{} {
 	result := make(map[string]interface{})
 	if c.HorizontalPodAutoscaling != nil {
 		result["horizontal_pod_autoscaling"] = []map[string]interface{}{
@@ -909,10 +1386,62 @@ func flattenClusterAddonsConfig(c *container.AddonsConfig) []map[string]interfac
 			},
 		}
 	}
+	if c.NetworkPolicyConfig != nil {
+		result["network_policy_config"] = []map[string]interface{}{
+			{
+				"disabled": c.NetworkPolicyConfig.Disabled,
+			},
+		}
+	}
 	return []map[string]interface{}{result}
 }
 
-func flattenClusterNodePools(d *schema.ResourceData, config *Config, c []*container.NodePool) ([]map[string]interface{}, error) {
+func flattenIPAllocationPolicy(c *containerBeta.IPAllocationPolicy) []map[string]interface{} {
+	if c == nil || !c.UseIpAliases {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"cluster_secondary_range_name":  c.ClusterSecondaryRangeName,
+			"services_secondary_range_name": c.ServicesSecondaryRangeName,
+			"cluster_ipv4_cidr_block":       c.ClusterIpv4CidrBlock,
+			"services_ipv4_cidr_block":      c.ServicesIpv4CidrBlock,
+			"create_subnetwork":             c.CreateSubnetwork,
+			"subnetwork_name":               c.SubnetworkName,
+		},
+	}
+}
+
+func flattenNetworkPolicy(c *containerBeta.NetworkPolicy) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	if c != nil {
+		result = append(result, map[string]interface{}{
+			"enabled":  c.Enabled,
+			"provider": c.Provider,
+		})
+	}
+	return result
+}
+
+func flattenMaintenancePolicy(mp *containerBeta.MaintenancePolicy) []map[string]interface{} {
+	if mp == nil || mp.Window == nil || mp.Window.DailyMaintenanceWindow == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"daily_maintenance_window": []map[string]interface{}{
+				{
+					"start_time": mp.Window.DailyMaintenanceWindow.StartTime,
+					"duration":   mp.Window.DailyMaintenanceWindow.Duration,
+				},
+			},
+		},
+	}
+}
+
+func flattenClusterNodePools(d *schema.ResourceData, config *Config, c []*containerBeta.NodePool) ([]map[string]interface{}, error) {
 	nodePools := make([]map[string]interface{}, 0, len(c))
 
 	for i, np := range c {
@@ -926,7 +1455,7 @@ func flattenClusterNodePools(d *schema.ResourceData, config *Config, c []*contai
 	return nodePools, nil
 }
 
-func flattenMasterAuthorizedNetworksConfig(c *container.MasterAuthorizedNetworksConfig) []map[string]interface{} {
+func flattenMasterAuthorizedNetworksConfig(c *containerBeta.MasterAuthorizedNetworksConfig) []map[string]interface{} {
 	result := make(map[string]interface{})
 	if c.Enabled && len(c.CidrBlocks) > 0 {
 		cidrBlocks := make([]map[string]interface{}, 0, len(c.CidrBlocks))
@@ -941,15 +1470,91 @@ func flattenMasterAuthorizedNetworksConfig(c *container.MasterAuthorizedNetworks
 	return []map[string]interface{}{result}
 }
 
+func expandPrivateClusterConfig(configured interface{}) *containerBeta.PrivateClusterConfig {
+	l := configured.([]interface{})
+	if len(l) == 0 {
+		return nil
+	}
+	config := l[0].(map[string]interface{})
+	return &containerBeta.PrivateClusterConfig{
+		EnablePrivateEndpoint: config["enable_private_endpoint"].(bool),
+		EnablePrivateNodes:    config["enable_private_nodes"].(bool),
+		MasterIpv4CidrBlock:   config["master_ipv4_cidr_block"].(string),
+	}
+}
+
+func flattenPrivateClusterConfig(c *containerBeta.PrivateClusterConfig) []map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"enable_private_endpoint": c.EnablePrivateEndpoint,
+			"enable_private_nodes":    c.EnablePrivateNodes,
+			"master_ipv4_cidr_block":  c.MasterIpv4CidrBlock,
+			"private_endpoint":        c.PrivateEndpoint,
+			"public_endpoint":         c.PublicEndpoint,
+		},
+	}
+}
+
+func expandPodSecurityPolicyConfig(configured interface{}) *containerBeta.PodSecurityPolicyConfig {
+	result := &containerBeta.PodSecurityPolicyConfig{}
+	if len(configured.([]interface{})) > 0 {
+		config := configured.([]interface{})[0].(map[string]interface{})
+		result.Enabled = config["enabled"].(bool)
+	}
+	result.ForceSendFields = []string{"Enabled"}
+	return result
+}
+
+func flattenPodSecurityPolicyConfig(c *containerBeta.PodSecurityPolicyConfig) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"enabled": c.Enabled,
+		},
+	}
+}
+
 func resourceContainerClusterStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
-		return nil, fmt.Errorf("Invalid container cluster specifier. Expecting {zone}/{name}")
+		return nil, fmt.Errorf("Invalid container cluster specifier. Expecting {zone}/{name} or {region}/{name}")
 	}
 
-	d.Set("zone", parts[0])
+	location := parts[0]
+	if isZone(location) {
+		d.Set("zone", location)
+	} else {
+		d.Set("region", location)
+	}
 	d.Set("name", parts[1])
 	d.SetId(parts[1])
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// getLocation returns the location of a GKE resource: its region for regional
+// clusters, or its zone otherwise.
+func getLocation(d TerraformResourceData, config *Config) (string, error) {
+	if v, ok := d.GetOk("region"); ok {
+		return v.(string), nil
+	}
+	if v, ok := d.GetOk("zone"); ok {
+		return v.(string), nil
+	}
+	return "", fmt.Errorf("Cannot determine location: set zone or region in this resource")
+}
+
+// Zones are a region followed by a suffix, e.g. us-central1 and us-central1-a.
+func isZone(location string) bool {
+	return len(strings.Split(location, "-")) == 3
+}
+
+func containerLocationName(project, location string) string {
+	return fmt.Sprintf("projects/%s/locations/%s", project, location)
+}
+
+func containerClusterFullName(project, location, cluster string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/clusters/%s", project, location, cluster)
+}
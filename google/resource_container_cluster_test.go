@@ -17,6 +17,45 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_container_cluster", &resource.Sweeper{
+		Name: "gcp_container_cluster",
+		F:    testSweepContainerClusters,
+	})
+}
+
+func testSweepContainerClusters(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	// Listing clusters isn't paginated, all of them are returned at once.
+	found, err := config.clientContainer.Projects.Locations.Clusters.List(containerLocationName(config.Project, "-")).Do()
+	if err != nil {
+		return fmt.Errorf("error listing container clusters: %s", err)
+	}
+
+	for _, cluster := range found.Clusters {
+		if cluster.Location != region && !strings.HasPrefix(cluster.Location, region+"-") {
+			continue
+		}
+
+		name, location := cluster.Name, cluster.Location
+		sweepResource("container cluster", name, func() error {
+			op, err := config.clientContainer.Projects.Locations.Clusters.Delete(
+				containerClusterFullName(config.Project, location, name)).Do()
+			if err != nil {
+				return err
+			}
+
+			return containerOperationWait(config, op, config.Project, location, "deleting GKE cluster", 10, 3)
+		})
+	}
+
+	return nil
+}
+
 func TestAccContainerCluster_basic(t *testing.T) {
 	t.Parallel()
 
@@ -88,6 +127,48 @@ func TestAccContainerCluster_withAddons(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_regional(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-regional-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_regional(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster("google_container_cluster.regional"),
+					resource.TestCheckResourceAttr("google_container_cluster.regional", "region", "us-central1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_regionalWithAdditionalZones(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-regional-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_regionalAdditionalZones(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster("google_container_cluster.with_additional_zones"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_additional_zones", "additional_zones.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_withMasterAuth(t *testing.T) {
 	t.Parallel()
 
@@ -225,6 +306,111 @@ func TestAccContainerCluster_withLegacyAbac(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_withNetworkPolicyEnabled(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withNetworkPolicyEnabled(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_network_policy_enabled"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_network_policy_enabled",
+						"network_policy.#", "1"),
+				),
+			},
+			{
+				Config: testAccContainerCluster_removeNetworkPolicy(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_network_policy_enabled"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_network_policy_enabled",
+						"network_policy.0.enabled", "false"),
+				),
+			},
+			{
+				Config: testAccContainerCluster_withNetworkPolicyEnabled(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_network_policy_enabled"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_network_policy_enabled",
+						"network_policy.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withMaintenanceWindow(clusterName, "03:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_maintenance_window"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_maintenance_window",
+						"maintenance_policy.0.daily_maintenance_window.0.start_time", "03:00"),
+				),
+			},
+			{
+				Config: testAccContainerCluster_withMaintenanceWindow(clusterName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_maintenance_window"),
+					resource.TestCheckNoResourceAttr("google_container_cluster.with_maintenance_window",
+						"maintenance_policy.0.daily_maintenance_window.0.start_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withPodSecurityPolicy(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withPodSecurityPolicy(clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_pod_security_policy"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_pod_security_policy",
+						"pod_security_policy_config.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccContainerCluster_withPodSecurityPolicy(clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_pod_security_policy"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_pod_security_policy",
+						"pod_security_policy_config.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_withVersion(t *testing.T) {
 	t.Parallel()
 
@@ -293,6 +479,31 @@ func TestAccContainerCluster_withNodeConfig(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_withNodeConfigTaints(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withNodeConfigTaints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster(
+						"google_container_cluster.with_node_config"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_node_config",
+						"node_config.0.taint.#", "2"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_node_config",
+						"node_config.0.taint.0.effect", "PREFER_NO_SCHEDULE"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_node_config",
+						"node_config.0.workload_metadata_config.0.node_metadata", "SECURE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_withNodeConfigScopeAlias(t *testing.T) {
 	t.Parallel()
 
@@ -333,6 +544,79 @@ func TestAccContainerCluster_network(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_withIPAllocationPolicy(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withIPAllocationPolicy(
+					cluster,
+					map[string]string{
+						"pods":     "10.1.0.0/16",
+						"services": "10.2.0.0/20",
+					},
+					map[string]string{
+						"cluster_secondary_range_name":  "pods",
+						"services_secondary_range_name": "services",
+					},
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster("google_container_cluster.with_ip_allocation_policy"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_ip_allocation_policy",
+						"ip_allocation_policy.0.cluster_secondary_range_name", "pods"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_ip_allocation_policy",
+						"ip_allocation_policy.0.services_secondary_range_name", "services"),
+				),
+			},
+			{
+				Config: testAccContainerCluster_withIPAllocationPolicy(
+					cluster,
+					map[string]string{},
+					map[string]string{
+						"cluster_ipv4_cidr_block":  "/16",
+						"services_ipv4_cidr_block": "/22",
+					},
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster("google_container_cluster.with_ip_allocation_policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withPrivateClusterConfig(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withPrivateClusterConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster("google_container_cluster.with_private_cluster"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_private_cluster",
+						"private_cluster_config.0.enable_private_nodes", "true"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_private_cluster",
+						"private_cluster_config.0.master_ipv4_cidr_block", "10.42.0.0/28"),
+					resource.TestCheckResourceAttrSet("google_container_cluster.with_private_cluster",
+						"private_cluster_config.0.private_endpoint"),
+					resource.TestCheckResourceAttrSet("google_container_cluster.with_private_cluster",
+						"private_cluster_config.0.public_endpoint"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_backend(t *testing.T) {
 	t.Parallel()
 
@@ -503,6 +787,37 @@ func TestAccContainerCluster_withNodePoolAutoscaling(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_withNodePoolManagement(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
+	npName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withNodePoolManagement(clusterName, npName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster("google_container_cluster.with_node_pool"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_node_pool", "node_pool.0.management.0.auto_repair", "false"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_node_pool", "node_pool.0.management.0.auto_upgrade", "false"),
+				),
+			},
+			{
+				Config: testAccContainerCluster_withNodePoolManagement(clusterName, npName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerCluster("google_container_cluster.with_node_pool"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_node_pool", "node_pool.0.management.0.auto_repair", "true"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_node_pool", "node_pool.0.management.0.auto_upgrade", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_withNodePoolNamePrefix(t *testing.T) {
 	t.Parallel()
 
@@ -585,8 +900,8 @@ func testAccCheckContainerClusterDestroy(s *terraform.State) error {
 		}
 
 		attributes := rs.Primary.Attributes
-		_, err := config.clientContainer.Projects.Zones.Clusters.Get(
-			config.Project, attributes["zone"], attributes["name"]).Do()
+		_, err := config.clientContainer.Projects.Locations.Clusters.Get(
+			containerClusterFullName(config.Project, testAccContainerLocation(attributes), attributes["name"])).Do()
 		if err == nil {
 			return fmt.Errorf("Cluster still exists")
 		}
@@ -595,6 +910,14 @@ func testAccCheckContainerClusterDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccContainerLocation returns the zone or region a GKE resource was created in.
+func testAccContainerLocation(attributes map[string]string) string {
+	if region := attributes["region"]; region != "" {
+		return region
+	}
+	return attributes["zone"]
+}
+
 var setFields map[string]struct{} = map[string]struct{}{
 	"additional_zones":                       struct{}{},
 	"node_config.0.oauth_scopes":             struct{}{},
@@ -609,8 +932,8 @@ func testAccCheckContainerCluster(n string) resource.TestCheckFunc {
 		}
 
 		config := testAccProvider.Meta().(*Config)
-		cluster, err := config.clientContainer.Projects.Zones.Clusters.Get(
-			config.Project, attributes["zone"], attributes["name"]).Do()
+		cluster, err := config.clientContainerBeta.Projects.Locations.Clusters.Get(
+			containerClusterFullName(config.Project, testAccContainerLocation(attributes), attributes["name"])).Do()
 		if err != nil {
 			return err
 		}
@@ -635,7 +958,6 @@ func testAccCheckContainerCluster(n string) resource.TestCheckFunc {
 			{"master_auth.0.cluster_ca_certificate", cluster.MasterAuth.ClusterCaCertificate},
 			{"master_auth.0.password", cluster.MasterAuth.Password},
 			{"master_auth.0.username", cluster.MasterAuth.Username},
-			{"zone", cluster.Zone},
 			{"cluster_ipv4_cidr", cluster.ClusterIpv4Cidr},
 			{"description", cluster.Description},
 			{"enable_kubernetes_alpha", strconv.FormatBool(cluster.EnableKubernetesAlpha)},
@@ -659,10 +981,16 @@ func testAccCheckContainerCluster(n string) resource.TestCheckFunc {
 			{"node_version", cluster.CurrentNodeVersion},
 		}
 
-		// Remove Zone from additional_zones since that's what the resource writes in state
+		if isZone(cluster.Location) {
+			clusterTests = append(clusterTests, clusterTestField{"zone", cluster.Location})
+		} else {
+			clusterTests = append(clusterTests, clusterTestField{"region", cluster.Location})
+		}
+
+		// Remove the cluster's zone from additional_zones since that's what the resource writes in state
 		additionalZones := []string{}
 		for _, location := range cluster.Locations {
-			if location != cluster.Zone {
+			if location != cluster.Location {
 				additionalZones = append(additionalZones, location)
 			}
 		}
@@ -684,6 +1012,44 @@ func testAccCheckContainerCluster(n string) resource.TestCheckFunc {
 		clusterTests = append(clusterTests, clusterTestField{"addons_config.0.http_load_balancing.0.disabled", httpLoadBalancingDisabled})
 		clusterTests = append(clusterTests, clusterTestField{"addons_config.0.horizontal_pod_autoscaling.0.disabled", horizontalPodAutoscalingDisabled})
 		clusterTests = append(clusterTests, clusterTestField{"addons_config.0.kubernetes_dashboard.0.disabled", kubernetesDashboardDisabled})
+		networkPolicyConfigDisabled := false
+		if cluster.AddonsConfig != nil && cluster.AddonsConfig.NetworkPolicyConfig != nil {
+			networkPolicyConfigDisabled = cluster.AddonsConfig.NetworkPolicyConfig.Disabled
+		}
+		clusterTests = append(clusterTests, clusterTestField{"addons_config.0.network_policy_config.0.disabled", networkPolicyConfigDisabled})
+
+		if cluster.IpAllocationPolicy != nil && cluster.IpAllocationPolicy.UseIpAliases {
+			clusterTests = append(clusterTests,
+				clusterTestField{"ip_allocation_policy.0.cluster_secondary_range_name", cluster.IpAllocationPolicy.ClusterSecondaryRangeName},
+				clusterTestField{"ip_allocation_policy.0.services_secondary_range_name", cluster.IpAllocationPolicy.ServicesSecondaryRangeName},
+				clusterTestField{"ip_allocation_policy.0.cluster_ipv4_cidr_block", cluster.IpAllocationPolicy.ClusterIpv4CidrBlock},
+				clusterTestField{"ip_allocation_policy.0.services_ipv4_cidr_block", cluster.IpAllocationPolicy.ServicesIpv4CidrBlock})
+		}
+
+		if cluster.PrivateClusterConfig != nil {
+			clusterTests = append(clusterTests,
+				clusterTestField{"private_cluster_config.0.enable_private_endpoint", cluster.PrivateClusterConfig.EnablePrivateEndpoint},
+				clusterTestField{"private_cluster_config.0.enable_private_nodes", cluster.PrivateClusterConfig.EnablePrivateNodes},
+				clusterTestField{"private_cluster_config.0.master_ipv4_cidr_block", cluster.PrivateClusterConfig.MasterIpv4CidrBlock},
+				clusterTestField{"private_cluster_config.0.private_endpoint", cluster.PrivateClusterConfig.PrivateEndpoint},
+				clusterTestField{"private_cluster_config.0.public_endpoint", cluster.PrivateClusterConfig.PublicEndpoint})
+		}
+
+		if cluster.NetworkPolicy != nil {
+			clusterTests = append(clusterTests,
+				clusterTestField{"network_policy.0.enabled", cluster.NetworkPolicy.Enabled},
+				clusterTestField{"network_policy.0.provider", cluster.NetworkPolicy.Provider})
+		}
+
+		if cluster.MaintenancePolicy != nil && cluster.MaintenancePolicy.Window != nil && cluster.MaintenancePolicy.Window.DailyMaintenanceWindow != nil {
+			clusterTests = append(clusterTests,
+				clusterTestField{"maintenance_policy.0.daily_maintenance_window.0.start_time", cluster.MaintenancePolicy.Window.DailyMaintenanceWindow.StartTime},
+				clusterTestField{"maintenance_policy.0.daily_maintenance_window.0.duration", cluster.MaintenancePolicy.Window.DailyMaintenanceWindow.Duration})
+		}
+
+		if cluster.PodSecurityPolicyConfig != nil {
+			clusterTests = append(clusterTests, clusterTestField{"pod_security_policy_config.0.enabled", cluster.PodSecurityPolicyConfig.Enabled})
+		}
 
 		for i, np := range cluster.NodePools {
 			prefix := fmt.Sprintf("node_pool.%d.", i)
@@ -1019,6 +1385,90 @@ resource "google_container_cluster" "with_legacy_abac" {
 }`, clusterName)
 }
 
+func testAccContainerCluster_regional(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "regional" {
+	name = "%s"
+	region = "us-central1"
+	initial_node_count = 1
+}`, clusterName)
+}
+
+func testAccContainerCluster_regionalAdditionalZones(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_additional_zones" {
+	name = "%s"
+	region = "us-central1"
+	initial_node_count = 1
+
+	additional_zones = [
+		"us-central1-f",
+		"us-central1-c",
+	]
+}`, clusterName)
+}
+
+func testAccContainerCluster_withNetworkPolicyEnabled(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_network_policy_enabled" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	network_policy {
+		enabled = true
+		provider = "CALICO"
+	}
+}`, clusterName)
+}
+
+func testAccContainerCluster_removeNetworkPolicy(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_network_policy_enabled" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	network_policy {
+		enabled = false
+	}
+}`, clusterName)
+}
+
+func testAccContainerCluster_withMaintenanceWindow(clusterName string, startTime string) string {
+	maintenancePolicy := ""
+	if len(startTime) > 0 {
+		maintenancePolicy = fmt.Sprintf(`
+	maintenance_policy {
+		daily_maintenance_window {
+			start_time = "%s"
+		}
+	}`, startTime)
+	}
+
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_maintenance_window" {
+	name = "cluster-test-%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	%s
+}`, clusterName, maintenancePolicy)
+}
+
+func testAccContainerCluster_withPodSecurityPolicy(clusterName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_pod_security_policy" {
+	name = "cluster-test-%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	pod_security_policy_config {
+		enabled = %v
+	}
+}`, clusterName, enabled)
+}
+
 func testAccContainerCluster_withVersion(clusterName string) string {
 	return fmt.Sprintf(`
 data "google_container_engine_versions" "central1a" {
@@ -1112,6 +1562,30 @@ resource "google_container_cluster" "with_node_config" {
 	}
 }`, acctest.RandString(10))
 
+var testAccContainerCluster_withNodeConfigTaints = fmt.Sprintf(`
+resource "google_container_cluster" "with_node_config" {
+	name = "cluster-test-%s"
+	zone = "us-central1-f"
+	initial_node_count = 1
+
+	node_config {
+		taint {
+			key = "taint_key"
+			value = "taint_value"
+			effect = "PREFER_NO_SCHEDULE"
+		}
+		taint {
+			key = "taint_key2"
+			value = "taint_value2"
+			effect = "NO_EXECUTE"
+		}
+
+		workload_metadata_config {
+			node_metadata = "SECURE"
+		}
+	}
+}`, acctest.RandString(10))
+
 var testAccContainerCluster_withNodeConfigScopeAlias = fmt.Sprintf(`
 resource "google_container_cluster" "with_node_config_scope_alias" {
 	name = "cluster-test-%s"
@@ -1130,6 +1604,97 @@ resource "google_container_cluster" "with_node_config_scope_alias" {
 	}
 }`, acctest.RandString(10))
 
+func testAccContainerCluster_withIPAllocationPolicy(cluster string, ranges, policy map[string]string) string {
+
+	var secondaryRanges bytes.Buffer
+	for rangeName, cidr := range ranges {
+		secondaryRanges.WriteString(fmt.Sprintf(`
+	secondary_ip_range {
+		range_name    = "%s"
+		ip_cidr_range = "%s"
+	}`, rangeName, cidr))
+	}
+
+	var ipAllocationPolicy bytes.Buffer
+	for key, value := range policy {
+		ipAllocationPolicy.WriteString(fmt.Sprintf(`
+		%s = "%s"`, key, value))
+	}
+
+	return fmt.Sprintf(`
+resource "google_compute_network" "container_network" {
+	name = "container-net-%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "container_subnetwork" {
+	name    = "${google_compute_network.container_network.name}"
+	network = "${google_compute_network.container_network.name}"
+	ip_cidr_range = "10.0.0.0/24"
+	region  = "us-central1"
+
+	%s
+}
+
+resource "google_container_cluster" "with_ip_allocation_policy" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	network = "${google_compute_network.container_network.name}"
+	subnetwork = "${google_compute_subnetwork.container_subnetwork.name}"
+
+	initial_node_count = 1
+	ip_allocation_policy {
+		%s
+	}
+}`, acctest.RandString(10), secondaryRanges.String(), cluster, ipAllocationPolicy.String())
+}
+
+func testAccContainerCluster_withPrivateClusterConfig(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "container_network" {
+	name = "container-net-%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "container_subnetwork" {
+	name    = "${google_compute_network.container_network.name}"
+	network = "${google_compute_network.container_network.name}"
+	ip_cidr_range = "10.0.35.0/24"
+	region  = "us-central1"
+	private_ip_google_access = true
+
+	secondary_ip_range {
+		range_name    = "pod"
+		ip_cidr_range = "10.0.0.0/19"
+	}
+
+	secondary_ip_range {
+		range_name    = "svc"
+		ip_cidr_range = "10.0.32.0/22"
+	}
+}
+
+resource "google_container_cluster" "with_private_cluster" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	network = "${google_compute_network.container_network.name}"
+	subnetwork = "${google_compute_subnetwork.container_subnetwork.name}"
+
+	private_cluster_config {
+		enable_private_nodes = true
+		master_ipv4_cidr_block = "10.42.0.0/28"
+	}
+
+	ip_allocation_policy {
+		cluster_secondary_range_name  = "${google_compute_subnetwork.container_subnetwork.secondary_ip_range.0.range_name}"
+		services_secondary_range_name = "${google_compute_subnetwork.container_subnetwork.secondary_ip_range.1.range_name}"
+	}
+}`, clusterName, clusterName)
+}
+
 var testAccContainerCluster_networkRef = fmt.Sprintf(`
 resource "google_compute_network" "container_network" {
 	name = "container-net-%s"
@@ -1350,6 +1915,23 @@ resource "google_container_cluster" "with_node_pool" {
 }`, cluster, np)
 }
 
+func testAccContainerCluster_withNodePoolManagement(cluster, np string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_node_pool" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	node_pool {
+		name               = "%s"
+		initial_node_count = 1
+		management {
+			auto_repair  = %t
+			auto_upgrade = %t
+		}
+	}
+}`, cluster, np, enabled, enabled)
+}
+
 var testAccContainerCluster_withNodePoolNamePrefix = fmt.Sprintf(`
 resource "google_container_cluster" "with_node_pool_name_prefix" {
 	name = "tf-cluster-nodepool-test-%s"
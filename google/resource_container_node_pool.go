@@ -10,8 +10,15 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/container/v1"
+	containerBeta "google.golang.org/api/container/v1beta1"
 )
 
+var ContainerNodePoolBaseApiVersion = v1
+var ContainerNodePoolVersionedFeatures = []Feature{
+	{Version: v1beta1, Item: "node_config.*.taint"},
+	{Version: v1beta1, Item: "node_config.*.workload_metadata_config"},
+}
+
 func resourceContainerNodePool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceContainerNodePoolCreate,
@@ -42,9 +49,16 @@ func resourceContainerNodePool() *schema.Resource {
 					ForceNew: true,
 				},
 				"zone": &schema.Schema{
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					ConflictsWith: []string{"region"},
+				},
+				"region": &schema.Schema{
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					ConflictsWith: []string{"zone"},
 				},
 				"cluster": &schema.Schema{
 					Type:     schema.TypeString,
@@ -85,6 +99,28 @@ var schemaNodePool = map[string]*schema.Schema{
 		Deprecated: "Use node_count instead",
 	},
 
+	"management": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_repair": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"auto_upgrade": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	},
+
 	"name": &schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
@@ -106,9 +142,16 @@ var schemaNodePool = map[string]*schema.Schema{
 		Computed:     true,
 		ValidateFunc: validation.IntAtLeast(1),
 	},
+
+	"version": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	},
 }
 
 func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	containerApiVersion := getContainerApiVersion(d, ContainerNodePoolBaseApiVersion, ContainerNodePoolVersionedFeatures)
 	config := meta.(*Config)
 
 	project, err := getProject(d, config)
@@ -121,21 +164,39 @@ func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	req := &container.CreateNodePoolRequest{
+	req := &containerBeta.CreateNodePoolRequest{
 		NodePool: nodePool,
 	}
 
-	zone := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
+
 	cluster := d.Get("cluster").(string)
 
-	op, err := config.clientContainer.Projects.Zones.Clusters.NodePools.Create(project, zone, cluster, req).Do()
+	var op interface{}
+	switch containerApiVersion {
+	case v1:
+		reqV1 := &container.CreateNodePoolRequest{}
+		err = Convert(req, reqV1)
+		if err != nil {
+			return err
+		}
+
+		op, err = config.clientContainer.Projects.Locations.Clusters.NodePools.Create(
+			containerClusterFullName(project, location, cluster), reqV1).Do()
+	case v1beta1:
+		op, err = config.clientContainerBeta.Projects.Locations.Clusters.NodePools.Create(
+			containerClusterFullName(project, location, cluster), req).Do()
+	}
 
 	if err != nil {
 		return fmt.Errorf("Error creating NodePool: %s", err)
 	}
 
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutCreate).Minutes())
-	waitErr := containerOperationWait(config, op, project, zone, "creating GKE NodePool", timeoutInMinutes, 3)
+	waitErr := containerSharedOperationWait(config, op, project, location, "creating GKE NodePool", timeoutInMinutes, 3)
 	if waitErr != nil {
 		// The resource didn't actually create
 		d.SetId("")
@@ -144,12 +205,13 @@ func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] GKE NodePool %s has been created", nodePool.Name)
 
-	d.SetId(fmt.Sprintf("%s/%s/%s", zone, cluster, nodePool.Name))
+	d.SetId(fmt.Sprintf("%s/%s/%s", location, cluster, nodePool.Name))
 
 	return resourceContainerNodePoolRead(d, meta)
 }
 
 func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	containerApiVersion := getContainerApiVersion(d, ContainerNodePoolBaseApiVersion, ContainerNodePoolVersionedFeatures)
 	config := meta.(*Config)
 
 	project, err := getProject(d, config)
@@ -157,14 +219,33 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	zone := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
+
 	cluster := d.Get("cluster").(string)
 	name := getNodePoolName(d.Id())
 
-	nodePool, err := config.clientContainer.Projects.Zones.Clusters.NodePools.Get(
-		project, zone, cluster, name).Do()
-	if err != nil {
-		return fmt.Errorf("Error reading NodePool: %s", err)
+	nodePool := &containerBeta.NodePool{}
+	switch containerApiVersion {
+	case v1:
+		nodePoolV1, err := config.clientContainer.Projects.Locations.Clusters.NodePools.Get(
+			containerNodePoolFullName(project, location, cluster, name)).Do()
+		if err != nil {
+			return fmt.Errorf("Error reading NodePool: %s", err)
+		}
+
+		err = Convert(nodePoolV1, nodePool)
+		if err != nil {
+			return err
+		}
+	case v1beta1:
+		nodePool, err = config.clientContainerBeta.Projects.Locations.Clusters.NodePools.Get(
+			containerNodePoolFullName(project, location, cluster, name)).Do()
+		if err != nil {
+			return fmt.Errorf("Error reading NodePool: %s", err)
+		}
 	}
 
 	npMap, err := flattenNodePool(d, config, nodePool, "")
@@ -200,19 +281,22 @@ func resourceContainerNodePoolDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	zone := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
 	name := d.Get("name").(string)
 	cluster := d.Get("cluster").(string)
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutDelete).Minutes())
 
-	op, err := config.clientContainer.Projects.Zones.Clusters.NodePools.Delete(
-		project, zone, cluster, name).Do()
+	op, err := config.clientContainer.Projects.Locations.Clusters.NodePools.Delete(
+		containerNodePoolFullName(project, location, cluster, name)).Do()
 	if err != nil {
 		return fmt.Errorf("Error deleting NodePool: %s", err)
 	}
 
 	// Wait until it's deleted
-	waitErr := containerOperationWait(config, op, project, zone, "deleting GKE NodePool", timeoutInMinutes, 2)
+	waitErr := containerOperationWait(config, op, project, location, "deleting GKE NodePool", timeoutInMinutes, 2)
 	if waitErr != nil {
 		return waitErr
 	}
@@ -232,12 +316,16 @@ func resourceContainerNodePoolExists(d *schema.ResourceData, meta interface{}) (
 		return false, err
 	}
 
-	zone := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return false, err
+	}
+
 	cluster := d.Get("cluster").(string)
 	name := getNodePoolName(d.Id())
 
-	_, err = config.clientContainer.Projects.Zones.Clusters.NodePools.Get(
-		project, zone, cluster, name).Do()
+	_, err = config.clientContainer.Projects.Locations.Clusters.NodePools.Get(
+		containerNodePoolFullName(project, location, cluster, name)).Do()
 	if err != nil {
 		if err = handleNotFoundError(err, d, fmt.Sprintf("Container NodePool %s", name)); err == nil {
 			return false, nil
@@ -251,17 +339,22 @@ func resourceContainerNodePoolExists(d *schema.ResourceData, meta interface{}) (
 func resourceContainerNodePoolStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 3 {
-		return nil, fmt.Errorf("Invalid container cluster specifier. Expecting {zone}/{cluster}/{name}")
+		return nil, fmt.Errorf("Invalid container cluster specifier. Expecting {zone}/{cluster}/{name} or {region}/{cluster}/{name}")
 	}
 
-	d.Set("zone", parts[0])
+	location := parts[0]
+	if isZone(location) {
+		d.Set("zone", location)
+	} else {
+		d.Set("region", location)
+	}
 	d.Set("cluster", parts[1])
 	d.Set("name", parts[2])
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func expandNodePool(d *schema.ResourceData, prefix string) (*container.NodePool, error) {
+func expandNodePool(d *schema.ResourceData, prefix string) (*containerBeta.NodePool, error) {
 	var name string
 	if v, ok := d.GetOk(prefix + "name"); ok {
 		name = v.(string)
@@ -285,7 +378,7 @@ func expandNodePool(d *schema.ResourceData, prefix string) (*container.NodePool,
 		return nil, fmt.Errorf("Node pool %s cannot be set with 0 node count", name)
 	}
 
-	np := &container.NodePool{
+	np := &containerBeta.NodePool{
 		Name:             name,
 		InitialNodeCount: int64(nodeCount),
 	}
@@ -296,7 +389,7 @@ func expandNodePool(d *schema.ResourceData, prefix string) (*container.NodePool,
 
 	if v, ok := d.GetOk(prefix + "autoscaling"); ok {
 		autoscaling := v.([]interface{})[0].(map[string]interface{})
-		np.Autoscaling = &container.NodePoolAutoscaling{
+		np.Autoscaling = &containerBeta.NodePoolAutoscaling{
 			Enabled:         true,
 			MinNodeCount:    int64(autoscaling["min_node_count"].(int)),
 			MaxNodeCount:    int64(autoscaling["max_node_count"].(int)),
@@ -304,10 +397,27 @@ func expandNodePool(d *schema.ResourceData, prefix string) (*container.NodePool,
 		}
 	}
 
+	if v, ok := d.GetOk(prefix + "management"); ok {
+		managementConfig := v.([]interface{})[0].(map[string]interface{})
+		np.Management = &containerBeta.NodeManagement{}
+
+		if v, ok := managementConfig["auto_repair"]; ok {
+			np.Management.AutoRepair = v.(bool)
+		}
+
+		if v, ok := managementConfig["auto_upgrade"]; ok {
+			np.Management.AutoUpgrade = v.(bool)
+		}
+	}
+
+	if v, ok := d.GetOk(prefix + "version"); ok {
+		np.Version = v.(string)
+	}
+
 	return np, nil
 }
 
-func flattenNodePool(d *schema.ResourceData, config *Config, np *container.NodePool, prefix string) (map[string]interface{}, error) {
+func flattenNodePool(d *schema.ResourceData, config *Config, np *containerBeta.NodePool, prefix string) (map[string]interface{}, error) {
 	// Node pools don't expose the current node count in their API, so read the
 	// instance groups instead. They should all have the same size, but in case a resize
 	// failed or something else strange happened, we'll just use the average size.
@@ -327,6 +437,7 @@ func flattenNodePool(d *schema.ResourceData, config *Config, np *container.NodeP
 		"initial_node_count": np.InitialNodeCount,
 		"node_count":         size / len(np.InstanceGroupUrls),
 		"node_config":        flattenNodeConfig(np.Config),
+		"version":            np.Version,
 	}
 
 	if np.Autoscaling != nil && np.Autoscaling.Enabled {
@@ -338,6 +449,15 @@ func flattenNodePool(d *schema.ResourceData, config *Config, np *container.NodeP
 		}
 	}
 
+	if np.Management != nil {
+		nodePool["management"] = []map[string]interface{}{
+			{
+				"auto_repair":  np.Management.AutoRepair,
+				"auto_upgrade": np.Management.AutoUpgrade,
+			},
+		}
+	}
+
 	return nodePool, nil
 }
 
@@ -349,7 +469,10 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, clusterName, prefi
 		return err
 	}
 
-	zone := d.Get("zone").(string)
+	location, err := getLocation(d, config)
+	if err != nil {
+		return err
+	}
 	npName := d.Get(prefix + "name").(string)
 
 	if d.HasChange(prefix + "autoscaling") {
@@ -373,14 +496,14 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, clusterName, prefi
 		req := &container.UpdateClusterRequest{
 			Update: update,
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.Update(
-			project, zone, clusterName, req).Do()
+		op, err := config.clientContainer.Projects.Locations.Clusters.Update(
+			containerClusterFullName(project, location, clusterName), req).Do()
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zone, "updating GKE node pool", timeoutInMinutes, 2)
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE node pool", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -397,13 +520,14 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, clusterName, prefi
 		req := &container.SetNodePoolSizeRequest{
 			NodeCount: newSize,
 		}
-		op, err := config.clientContainer.Projects.Zones.Clusters.NodePools.SetSize(project, zone, clusterName, npName, req).Do()
+		op, err := config.clientContainer.Projects.Locations.Clusters.NodePools.SetSize(
+			containerNodePoolFullName(project, location, clusterName, npName), req).Do()
 		if err != nil {
 			return err
 		}
 
 		// Wait until it's updated
-		waitErr := containerOperationWait(config, op, project, zone, "updating GKE node pool size", timeoutInMinutes, 2)
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE node pool size", timeoutInMinutes, 2)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -415,9 +539,66 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, clusterName, prefi
 		}
 	}
 
+	if d.HasChange(prefix + "management") {
+		management := &container.NodeManagement{}
+		if v, ok := d.GetOk(prefix + "management"); ok {
+			managementConfig := v.([]interface{})[0].(map[string]interface{})
+			management.AutoRepair = managementConfig["auto_repair"].(bool)
+			management.AutoUpgrade = managementConfig["auto_upgrade"].(bool)
+			management.ForceSendFields = []string{"AutoRepair", "AutoUpgrade"}
+		}
+		req := &container.SetNodePoolManagementRequest{
+			Management: management,
+		}
+		op, err := config.clientContainer.Projects.Locations.Clusters.NodePools.SetManagement(
+			containerNodePoolFullName(project, location, clusterName, npName), req).Do()
+		if err != nil {
+			return err
+		}
+
+		// Wait until it's updated
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE node pool management", timeoutInMinutes, 2)
+		if waitErr != nil {
+			return waitErr
+		}
+
+		log.Printf("[INFO] Updated management in Node Pool %s", npName)
+
+		if prefix == "" {
+			d.SetPartial("management")
+		}
+	}
+
+	if d.HasChange(prefix + "version") {
+		req := &container.UpdateNodePoolRequest{
+			NodeVersion: d.Get(prefix + "version").(string),
+		}
+		op, err := config.clientContainer.Projects.Locations.Clusters.NodePools.Update(
+			containerNodePoolFullName(project, location, clusterName, npName), req).Do()
+		if err != nil {
+			return err
+		}
+
+		// Wait until it's updated
+		waitErr := containerOperationWait(config, op, project, location, "updating GKE node pool version", timeoutInMinutes, 2)
+		if waitErr != nil {
+			return waitErr
+		}
+
+		log.Printf("[INFO] Updated version in Node Pool %s", npName)
+
+		if prefix == "" {
+			d.SetPartial("version")
+		}
+	}
+
 	return nil
 }
 
+func containerNodePoolFullName(project, location, cluster, nodePool string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/clusters/%s/nodePools/%s", project, location, cluster, nodePool)
+}
+
 func getNodePoolName(id string) string {
 	// name can be specified with name, name_prefix, or neither, so read it from the id.
 	return strings.Split(id, "/")[2]
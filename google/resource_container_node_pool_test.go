@@ -34,6 +34,27 @@ func TestAccContainerNodePool_basic(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_regionalClusters(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_regionalClusters(cluster, np),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerNodePoolMatches("google_container_node_pool.np"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccContainerNodePool_namePrefix(t *testing.T) {
 	t.Parallel()
 
@@ -92,6 +113,35 @@ func TestAccContainerNodePool_withNodeConfig(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_withGPU(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_withGPU(cluster, np),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerNodePoolMatches("google_container_node_pool.np_with_gpu"),
+					resource.TestCheckResourceAttr("google_container_node_pool.np_with_gpu",
+						"node_config.0.guest_accelerator.#", "1"),
+					resource.TestCheckResourceAttr("google_container_node_pool.np_with_gpu",
+						"node_config.0.guest_accelerator.0.count", "1"),
+					resource.TestCheckResourceAttr("google_container_node_pool.np_with_gpu",
+						"node_config.0.guest_accelerator.0.type", "nvidia-tesla-k80"),
+					resource.TestCheckResourceAttr("google_container_node_pool.np_with_gpu",
+						"node_config.0.taint.0.key", "dedicated"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccContainerNodePool_withNodeConfigScopeAlias(t *testing.T) {
 	t.Parallel()
 
@@ -178,6 +228,77 @@ func TestAccContainerNodePool_resize(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_withManagement(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	nodePool := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	management := `
+	management {
+		auto_repair = "true"
+		auto_upgrade = "true"
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_withManagement(cluster, nodePool, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerNodePoolMatches("google_container_node_pool.np_with_management"),
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.np_with_management", "management.#", "1"),
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.np_with_management", "management.0.auto_repair", "false"),
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.np_with_management", "management.0.auto_upgrade", "false"),
+				),
+			},
+			{
+				Config: testAccContainerNodePool_withManagement(cluster, nodePool, management),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerNodePoolMatches("google_container_node_pool.np_with_management"),
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.np_with_management", "management.#", "1"),
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.np_with_management", "management.0.auto_repair", "true"),
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.np_with_management", "management.0.auto_upgrade", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerNodePool_version(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_version(cluster, np),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerNodePoolMatches("google_container_node_pool.np"),
+				),
+			},
+			{
+				Config: testAccContainerNodePool_updateVersion(cluster, np),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerNodePoolMatches("google_container_node_pool.np"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContainerNodePoolDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -187,8 +308,8 @@ func testAccCheckContainerNodePoolDestroy(s *terraform.State) error {
 		}
 
 		attributes := rs.Primary.Attributes
-		_, err := config.clientContainer.Projects.Zones.Clusters.NodePools.Get(
-			config.Project, attributes["zone"], attributes["cluster"], attributes["name"]).Do()
+		_, err := config.clientContainer.Projects.Locations.Clusters.NodePools.Get(
+			containerNodePoolFullName(config.Project, testAccContainerLocation(attributes), attributes["cluster"], attributes["name"])).Do()
 		if err == nil {
 			return fmt.Errorf("NodePool still exists")
 		}
@@ -211,8 +332,8 @@ func testAccCheckContainerNodePoolMatches(n string) resource.TestCheckFunc {
 		}
 
 		attributes := rs.Primary.Attributes
-		nodepool, err := config.clientContainer.Projects.Zones.Clusters.NodePools.Get(
-			config.Project, attributes["zone"], attributes["cluster"], attributes["name"]).Do()
+		nodepool, err := config.clientContainer.Projects.Locations.Clusters.NodePools.Get(
+			containerNodePoolFullName(config.Project, testAccContainerLocation(attributes), attributes["cluster"], attributes["name"])).Do()
 		if err != nil {
 			return err
 		}
@@ -239,6 +360,13 @@ func testAccCheckContainerNodePoolMatches(n string) resource.TestCheckFunc {
 			{"node_config.0.tags", nodepool.Config.Tags},
 			{"node_config.0.preemptible", nodepool.Config.Preemptible},
 			{"node_config.0.min_cpu_platform", nodepool.Config.MinCpuPlatform},
+			{"version", nodepool.Version},
+		}
+
+		if nodepool.Management != nil {
+			nodepoolTests = append(nodepoolTests,
+				nodepoolTestField{"management.0.auto_repair", nodepool.Management.AutoRepair},
+				nodepoolTestField{"management.0.auto_upgrade", nodepool.Management.AutoUpgrade})
 		}
 
 		for _, attrs := range nodepoolTests {
@@ -289,6 +417,22 @@ resource "google_container_node_pool" "np" {
 }`, cluster, np)
 }
 
+func testAccContainerNodePool_regionalClusters(cluster, np string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+	name = "%s"
+	region = "us-central1"
+	initial_node_count = 3
+}
+
+resource "google_container_node_pool" "np" {
+	name = "%s"
+	region = "us-central1"
+	cluster = "${google_container_cluster.cluster.name}"
+	initial_node_count = 2
+}`, cluster, np)
+}
+
 func testAccContainerNodePool_namePrefix(cluster, np string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
@@ -412,6 +556,76 @@ resource "google_container_node_pool" "np" {
 }`, cluster, nodePool)
 }
 
+func testAccContainerNodePool_withManagement(cluster, nodePool, management string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+}
+
+resource "google_container_node_pool" "np_with_management" {
+	name = "%s"
+	zone = "us-central1-a"
+	cluster = "${google_container_cluster.cluster.name}"
+	initial_node_count = 1
+
+	%s
+
+	node_config {
+		machine_type = "g1-small"
+		disk_size_gb = 10
+		oauth_scopes = ["compute-rw", "storage-ro", "logging-write", "monitoring"]
+	}
+}`, cluster, nodePool, management)
+}
+
+func testAccContainerNodePool_version(cluster, np string) string {
+	return fmt.Sprintf(`
+data "google_container_engine_versions" "central1a" {
+	zone = "us-central1-a"
+}
+
+resource "google_container_cluster" "cluster" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+	min_master_version = "${data.google_container_engine_versions.central1a.latest_master_version}"
+}
+
+resource "google_container_node_pool" "np" {
+	name = "%s"
+	zone = "us-central1-a"
+	cluster = "${google_container_cluster.cluster.name}"
+	initial_node_count = 1
+
+	version = "${data.google_container_engine_versions.central1a.valid_node_versions.1}"
+}`, cluster, np)
+}
+
+func testAccContainerNodePool_updateVersion(cluster, np string) string {
+	return fmt.Sprintf(`
+data "google_container_engine_versions" "central1a" {
+	zone = "us-central1-a"
+}
+
+resource "google_container_cluster" "cluster" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+	min_master_version = "${data.google_container_engine_versions.central1a.latest_master_version}"
+}
+
+resource "google_container_node_pool" "np" {
+	name = "%s"
+	zone = "us-central1-a"
+	cluster = "${google_container_cluster.cluster.name}"
+	initial_node_count = 1
+
+	version = "${data.google_container_engine_versions.central1a.valid_node_versions.0}"
+}`, cluster, np)
+}
+
 func nodepoolCheckMatch(attributes map[string]string, attr string, gcp interface{}) string {
 	if gcpList, ok := gcp.([]string); ok {
 		return nodepoolCheckListMatch(attributes, attr, gcpList)
@@ -522,6 +736,43 @@ resource "google_container_node_pool" "np_with_node_config" {
 	}
 }`, acctest.RandString(10), acctest.RandString(10))
 
+func testAccContainerNodePool_withGPU(cluster, np string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+	name = "%s"
+	zone = "us-central1-c"
+	initial_node_count = 1
+}
+
+resource "google_container_node_pool" "np_with_gpu" {
+	name = "%s"
+	zone = "us-central1-c"
+	cluster = "${google_container_cluster.cluster.name}"
+	initial_node_count = 1
+
+	node_config {
+		machine_type = "n1-standard-1"
+		disk_size_gb = 10
+		oauth_scopes = [
+			"https://www.googleapis.com/auth/devstorage.read_only",
+			"https://www.googleapis.com/auth/logging.write",
+			"https://www.googleapis.com/auth/monitoring"
+		]
+
+		guest_accelerator {
+			type = "nvidia-tesla-k80"
+			count = 1
+		}
+
+		taint {
+			key = "dedicated"
+			value = "gpu"
+			effect = "NO_SCHEDULE"
+		}
+	}
+}`, cluster, np)
+}
+
 var testAccContainerNodePool_withNodeConfigScopeAlias = fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
 	name = "tf-cluster-nodepool-test-%s"
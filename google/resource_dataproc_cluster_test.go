@@ -17,6 +17,48 @@ import (
 	"google.golang.org/api/googleapi"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_dataproc_cluster", &resource.Sweeper{
+		Name: "gcp_dataproc_cluster",
+		F:    testSweepDataprocClusters,
+	})
+}
+
+func testSweepDataprocClusters(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	// Clusters are created in the global region unless the test specifies one.
+	for _, dataprocRegion := range []string{"global", region} {
+		token := ""
+		for paginate := true; paginate; {
+			found, err := config.clientDataproc.Projects.Regions.Clusters.List(config.Project, dataprocRegion).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("error listing Dataproc clusters in region %s: %s", dataprocRegion, err)
+			}
+
+			for _, cluster := range found.Clusters {
+				name, clusterRegion := cluster.ClusterName, dataprocRegion
+				sweepResource("Dataproc cluster", name, func() error {
+					op, err := config.clientDataproc.Projects.Regions.Clusters.Delete(config.Project, clusterRegion, name).Do()
+					if err != nil {
+						return err
+					}
+
+					return dataprocClusterOperationWait(config, op, "deleting Dataproc cluster", 10, 3)
+				})
+			}
+
+			token = found.NextPageToken
+			paginate = token != ""
+		}
+	}
+
+	return nil
+}
+
 const emptyTFDefinition = `
 # empty def
 `
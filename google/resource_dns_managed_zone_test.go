@@ -10,6 +10,66 @@ import (
 	"google.golang.org/api/dns/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_dns_managed_zone", &resource.Sweeper{
+		Name: "gcp_dns_managed_zone",
+		F:    testSweepDnsManagedZones,
+	})
+}
+
+func testSweepDnsManagedZones(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientDns.ManagedZones.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing DNS managed zones: %s", err)
+		}
+
+		for _, zone := range found.ManagedZones {
+			name := zone.Name
+			sweepResource("DNS managed zone", name, func() error {
+				// Zones can only be deleted once they hold nothing but their NS and SOA records.
+				chg := &dns.Change{}
+				rrsetsToken := ""
+				for paginateRrsets := true; paginateRrsets; {
+					rrsets, err := config.clientDns.ResourceRecordSets.List(config.Project, name).PageToken(rrsetsToken).Do()
+					if err != nil {
+						return err
+					}
+
+					for _, rrset := range rrsets.Rrsets {
+						if rrset.Type == "NS" || rrset.Type == "SOA" {
+							continue
+						}
+						chg.Deletions = append(chg.Deletions, rrset)
+					}
+
+					rrsetsToken = rrsets.NextPageToken
+					paginateRrsets = rrsetsToken != ""
+				}
+
+				if len(chg.Deletions) > 0 {
+					if _, err := config.clientDns.Changes.Create(config.Project, name, chg).Do(); err != nil {
+						return err
+					}
+				}
+
+				return config.clientDns.ManagedZones.Delete(config.Project, name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccDnsManagedZone_basic(t *testing.T) {
 	t.Parallel()
 
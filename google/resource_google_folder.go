@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
-	"strings"
 )
 
 func resourceGoogleFolder() *schema.Resource {
@@ -158,13 +157,12 @@ func resourceGoogleFolderDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGoogleFolderImportState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	id := d.Id()
-
-	if !strings.HasPrefix(d.Id(), "folders/") {
-		id = fmt.Sprintf("folders/%s", id)
+	folder, err := ParseFolderFieldValue(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	d.SetId(id)
+	d.SetId(folder.RelativeLink())
 
 	return []*schema.ResourceData{d}, nil
 }
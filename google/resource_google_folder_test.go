@@ -5,12 +5,55 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"log"
 	"os"
 	"testing"
 
 	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_folder", &resource.Sweeper{
+		Name:         "gcp_folder",
+		Dependencies: []string{"gcp_project"},
+		F:            testSweepFolders,
+	})
+}
+
+func testSweepFolders(region string) error {
+	org := os.Getenv("GOOGLE_ORG")
+	if org == "" {
+		log.Printf("[INFO] GOOGLE_ORG is not set, skipping folder sweeper")
+		return nil
+	}
+
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientResourceManagerV2Beta1.Folders.List().Parent("organizations/" + org).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing folders: %s", err)
+		}
+
+		for _, folder := range found.Folders {
+			name := folder.Name
+			sweepResource("folder", folder.DisplayName, func() error {
+				_, err := config.clientResourceManagerV2Beta1.Folders.Delete(name).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccGoogleFolder_rename(t *testing.T) {
 	t.Parallel()
 
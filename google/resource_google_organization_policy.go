@@ -130,14 +130,17 @@ func resourceGoogleOrganizationPolicyCreate(d *schema.ResourceData, meta interfa
 
 func resourceGoogleOrganizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	org := "organizations/" + d.Get("org_id").(string)
+	org, err := ParseOrganizationFieldValue(d.Get("org_id").(string))
+	if err != nil {
+		return err
+	}
 
-	policy, err := config.clientResourceManager.Organizations.GetOrgPolicy(org, &cloudresourcemanager.GetOrgPolicyRequest{
+	policy, err := config.clientResourceManager.Organizations.GetOrgPolicy(org.RelativeLink(), &cloudresourcemanager.GetOrgPolicyRequest{
 		Constraint: canonicalOrgPolicyConstraint(d.Get("constraint").(string)),
 	}).Do()
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Organization policy for %s", org))
+		return handleNotFoundError(err, d, fmt.Sprintf("Organization policy for %s", org.RelativeLink()))
 	}
 
 	d.Set("constraint", policy.Constraint)
@@ -160,9 +163,12 @@ func resourceGoogleOrganizationPolicyUpdate(d *schema.ResourceData, meta interfa
 
 func resourceGoogleOrganizationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	org := "organizations/" + d.Get("org_id").(string)
+	org, err := ParseOrganizationFieldValue(d.Get("org_id").(string))
+	if err != nil {
+		return err
+	}
 
-	_, err := config.clientResourceManager.Organizations.ClearOrgPolicy(org, &cloudresourcemanager.ClearOrgPolicyRequest{
+	_, err = config.clientResourceManager.Organizations.ClearOrgPolicy(org.RelativeLink(), &cloudresourcemanager.ClearOrgPolicyRequest{
 		Constraint: canonicalOrgPolicyConstraint(d.Get("constraint").(string)),
 	}).Do()
 
@@ -187,14 +193,17 @@ func resourceGoogleOrganizationPolicyImportState(d *schema.ResourceData, meta in
 
 func setOrganizationPolicy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	org := "organizations/" + d.Get("org_id").(string)
+	org, err := ParseOrganizationFieldValue(d.Get("org_id").(string))
+	if err != nil {
+		return err
+	}
 
 	listPolicy, err := expandListOrganizationPolicy(d.Get("list_policy").([]interface{}))
 	if err != nil {
 		return err
 	}
 
-	_, err = config.clientResourceManager.Organizations.SetOrgPolicy(org, &cloudresourcemanager.SetOrgPolicyRequest{
+	_, err = config.clientResourceManager.Organizations.SetOrgPolicy(org.RelativeLink(), &cloudresourcemanager.SetOrgPolicyRequest{
 		Policy: &cloudresourcemanager.OrgPolicy{
 			Constraint:    canonicalOrgPolicyConstraint(d.Get("constraint").(string)),
 			BooleanPolicy: expandBooleanOrganizationPolicy(d.Get("boolean_policy").([]interface{})),
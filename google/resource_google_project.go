@@ -73,8 +73,9 @@ func resourceGoogleProject() *schema.Resource {
 				Computed: true,
 			},
 			"billing_account": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				StateFunc: parseBillingAccountId,
 			},
 			"labels": &schema.Schema{
 				Type:     schema.TypeMap,
@@ -123,8 +124,12 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 	// Set the billing account
 	if v, ok := d.GetOk("billing_account"); ok {
 		name := v.(string)
+		billingAccount, err := ParseBillingAccountFieldValue(name)
+		if err != nil {
+			return err
+		}
 		ba := cloudbilling.ProjectBillingInfo{
-			BillingAccountName: "billingAccounts/" + name,
+			BillingAccountName: billingAccount.RelativeLink(),
 		}
 		_, err = config.clientBilling.Projects.UpdateBillingInfo(prefixedProject(pid), &ba).Do()
 		if err != nil {
@@ -184,11 +189,14 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 		// the `billingAccounts/` prefix, so we need to remove that. If the
 		// prefix ever changes, we'll validate to make sure it's something we
 		// recognize.
-		_ba := strings.TrimPrefix(ba.BillingAccountName, "billingAccounts/")
-		if ba.BillingAccountName == _ba {
+		if !strings.HasPrefix(ba.BillingAccountName, "billingAccounts/") {
 			return fmt.Errorf("Error parsing billing account for project %q. Expected value to begin with 'billingAccounts/' but got %s", prefixedProject(pid), ba.BillingAccountName)
 		}
-		d.Set("billing_account", _ba)
+		billingAccount, err := ParseBillingAccountFieldValue(ba.BillingAccountName)
+		if err != nil {
+			return fmt.Errorf("Error parsing billing account for project %q: %s", prefixedProject(pid), err)
+		}
+		d.Set("billing_account", billingAccount.Id)
 	}
 	return nil
 }
@@ -216,11 +224,19 @@ func getParentResourceId(d *schema.ResourceData, p *cloudresourcemanager.Project
 }
 
 func parseFolderId(v interface{}) string {
-	folderId := v.(string)
-	if strings.HasPrefix(folderId, "folders/") {
-		return folderId[8:]
+	folder, err := ParseFolderFieldValue(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+	return folder.Id
+}
+
+func parseBillingAccountId(v interface{}) string {
+	billingAccount, err := ParseBillingAccountFieldValue(v.(string))
+	if err != nil {
+		return v.(string)
 	}
-	return folderId
+	return billingAccount.Id
 }
 
 func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -270,7 +286,11 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 		billing_name := d.Get("billing_account").(string)
 		ba := cloudbilling.ProjectBillingInfo{}
 		if billing_name != "" {
-			ba.BillingAccountName = "billingAccounts/" + billing_name
+			billingAccount, err := ParseBillingAccountFieldValue(billing_name)
+			if err != nil {
+				return err
+			}
+			ba.BillingAccountName = billingAccount.RelativeLink()
 		}
 		_, err = config.clientBilling.Projects.UpdateBillingInfo(prefixedProject(pid), &ba).Do()
 		if err != nil {
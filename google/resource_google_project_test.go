@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"reflect"
 	"strconv"
@@ -15,6 +16,54 @@ import (
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_project", &resource.Sweeper{
+		Name: "gcp_project",
+		F:    testSweepProjects,
+	})
+}
+
+// Projects are only swept from the test organization, and only when both their id and
+// name match the ones used by the acceptance tests.
+func testSweepProjects(region string) error {
+	org := os.Getenv("GOOGLE_ORG")
+	if org == "" {
+		log.Printf("[INFO] GOOGLE_ORG is not set, skipping project sweeper")
+		return nil
+	}
+
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	filter := fmt.Sprintf("parent.type:organization parent.id:%s lifecycleState:ACTIVE", org)
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientResourceManager.Projects.List().Filter(filter).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing projects: %s", err)
+		}
+
+		for _, project := range found.Projects {
+			if !strings.HasPrefix(project.ProjectId, "terraform-") || project.Name != pname {
+				continue
+			}
+
+			pid := project.ProjectId
+			deleteSweptResource("project", pid, func() error {
+				_, err := config.clientResourceManager.Projects.Delete(pid).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 var (
 	org = multiEnvSearch([]string{
 		"GOOGLE_ORG",
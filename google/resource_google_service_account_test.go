@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -10,6 +11,42 @@ import (
 	"os"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_service_account", &resource.Sweeper{
+		Name: "gcp_service_account",
+		F:    testSweepServiceAccounts,
+	})
+}
+
+func testSweepServiceAccounts(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientIAM.Projects.ServiceAccounts.List("projects/" + config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing service accounts: %s", err)
+		}
+
+		for _, account := range found.Accounts {
+			fullName := account.Name
+			accountId := strings.Split(account.Email, "@")[0]
+			sweepResource("service account", accountId, func() error {
+				_, err := config.clientIAM.Projects.ServiceAccounts.Delete(fullName).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 // Test that a service account resource can be created, updated, and destroyed
 func TestAccGoogleServiceAccount_basic(t *testing.T) {
 	t.Parallel()
@@ -10,6 +10,41 @@ import (
 	"testing"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_logging_project_sink", &resource.Sweeper{
+		Name: "gcp_logging_project_sink",
+		F:    testSweepLoggingProjectSinks,
+	})
+}
+
+func testSweepLoggingProjectSinks(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientLogging.Projects.Sinks.List("projects/" + config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing logging sinks: %s", err)
+		}
+
+		for _, sink := range found.Sinks {
+			name := sink.Name
+			sweepResource("logging sink", name, func() error {
+				_, err := config.clientLogging.Projects.Sinks.Delete(fmt.Sprintf("projects/%s/sinks/%s", config.Project, name)).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccLoggingProjectSink_basic(t *testing.T) {
 	t.Parallel()
 
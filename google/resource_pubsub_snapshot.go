@@ -0,0 +1,183 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/pubsub/v1"
+)
+
+func resourcePubsubSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePubsubSnapshotCreate,
+		Read:   resourcePubsubSnapshotRead,
+		Update: resourcePubsubSnapshotUpdate,
+		Delete: resourcePubsubSnapshotDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourcePubsubSnapshotStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subscription": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: comparePubsubSnapshotSubscription,
+			},
+
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"topic": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePubsubSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	name := getComputedSnapshotName(project, d.Get("name").(string))
+	subscription := getComputedSubscriptionName(project, d.Get("subscription").(string))
+
+	res, err := config.clientPubsub.Projects.Snapshots.Create(name, &pubsub.CreateSnapshotRequest{
+		Subscription: subscription,
+		Labels:       expandLabels(d),
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating snapshot %q of subscription %q: %s", name, subscription, err)
+	}
+
+	d.SetId(res.Name)
+	// The subscription isn't returned by the API, so it's kept as configured.
+	d.Set("subscription", subscription)
+
+	return resourcePubsubSnapshotRead(d, meta)
+}
+
+func resourcePubsubSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	name := d.Id()
+	snapshot, err := config.clientPubsub.Projects.Snapshots.Get(name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Pubsub Snapshot %q", name))
+	}
+
+	d.Set("name", GetResourceNameFromSelfLink(snapshot.Name))
+	d.Set("project", getPubsubProjectFromName(snapshot.Name))
+	d.Set("topic", snapshot.Topic)
+	d.Set("expire_time", snapshot.ExpireTime)
+	d.Set("labels", snapshot.Labels)
+	d.Set("path", snapshot.Name)
+
+	return nil
+}
+
+func resourcePubsubSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("labels") {
+		_, err := config.clientPubsub.Projects.Snapshots.Patch(d.Id(), &pubsub.UpdateSnapshotRequest{
+			Snapshot: &pubsub.Snapshot{
+				Labels: expandLabels(d),
+			},
+			UpdateMask: "labels",
+		}).Do()
+
+		if err != nil {
+			return fmt.Errorf("Error updating snapshot '%s': %s", d.Get("name"), err)
+		}
+	}
+
+	return resourcePubsubSnapshotRead(d, meta)
+}
+
+func resourcePubsubSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	name := d.Id()
+	_, err := config.clientPubsub.Projects.Snapshots.Delete(name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Pubsub Snapshot %q", name))
+	}
+
+	return nil
+}
+
+func resourcePubsubSnapshotStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(getComputedSnapshotName(project, d.Id()))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// comparePubsubSnapshotSubscription suppresses the diff on the subscription of
+// an imported snapshot. The API doesn't return the subscription a snapshot was
+// taken from, so it's empty in the state after import.
+func comparePubsubSnapshotSubscription(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" && d.Id() != "" {
+		return true
+	}
+
+	return compareSelfLinkOrResourceName(k, old, new, d)
+}
+
+func getComputedSnapshotName(project string, snapshot string) string {
+	match, _ := regexp.MatchString("projects\\/.*\\/snapshots\\/.*", snapshot)
+	if match {
+		return snapshot
+	}
+	return fmt.Sprintf("projects/%s/snapshots/%s", project, snapshot)
+}
+
+// getPubsubProjectFromName returns the project of a pubsub resource name in
+// the format projects/{project}/{collection}/{name}.
+func getPubsubProjectFromName(name string) string {
+	parts := regexp.MustCompile("^projects/([^/]+)/").FindStringSubmatch(name)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[1]
+}
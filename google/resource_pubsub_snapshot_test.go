@@ -0,0 +1,161 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccPubsubSnapshot_basic(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+	snapshot := fmt.Sprintf("tf-test-snapshot-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSnapshot_basic(topic, subscription, snapshot, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubSnapshotExists("google_pubsub_snapshot.foo"),
+					resource.TestCheckResourceAttrSet("google_pubsub_snapshot.foo", "expire_time"),
+					resource.TestCheckResourceAttrSet("google_pubsub_snapshot.foo", "topic"),
+					resource.TestCheckResourceAttr("google_pubsub_snapshot.foo", "labels.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccPubsubSnapshot_basic(topic, subscription, snapshot, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubSnapshotExists("google_pubsub_snapshot.foo"),
+					resource.TestCheckResourceAttr("google_pubsub_snapshot.foo", "labels.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestComparePubsubSnapshotSubscription(t *testing.T) {
+	imported := resourcePubsubSnapshot().Data(&terraform.InstanceState{
+		ID: "projects/my-project/snapshots/my-snapshot",
+	})
+	created := resourcePubsubSnapshot().Data(nil)
+
+	cases := map[string]struct {
+		Old, New           string
+		Data               *schema.ResourceData
+		ExpectDiffSuppress bool
+	}{
+		"imported": {
+			Old:                "",
+			New:                "my-subscription",
+			Data:               imported,
+			ExpectDiffSuppress: true,
+		},
+		"new snapshot": {
+			Old:                "",
+			New:                "my-subscription",
+			Data:               created,
+			ExpectDiffSuppress: false,
+		},
+		"same subscription": {
+			Old:                "projects/my-project/subscriptions/my-subscription",
+			New:                "my-subscription",
+			Data:               imported,
+			ExpectDiffSuppress: true,
+		},
+		"other subscription": {
+			Old:                "projects/my-project/subscriptions/my-subscription",
+			New:                "other-subscription",
+			Data:               imported,
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if comparePubsubSnapshotSubscription("subscription", tc.Old, tc.New, tc.Data) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, %q => %q expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestGetPubsubProjectFromName(t *testing.T) {
+	cases := map[string]struct {
+		Name     string
+		Expected string
+	}{
+		"snapshot":     {Name: "projects/my-project/snapshots/my-snapshot", Expected: "my-project"},
+		"subscription": {Name: "projects/my-project/subscriptions/my-sub", Expected: "my-project"},
+		"short name":   {Name: "my-snapshot", Expected: ""},
+	}
+
+	for tn, tc := range cases {
+		if got := getPubsubProjectFromName(tc.Name); got != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func testAccCheckPubsubSnapshotDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_pubsub_snapshot" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		snapshot, _ := config.clientPubsub.Projects.Snapshots.Get(rs.Primary.ID).Do()
+		if snapshot != nil {
+			return fmt.Errorf("Snapshot still present")
+		}
+	}
+
+	return nil
+}
+
+func testAccPubsubSnapshotExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+		config := testAccProvider.Meta().(*Config)
+		_, err := config.clientPubsub.Projects.Snapshots.Get(rs.Primary.ID).Do()
+		if err != nil {
+			return fmt.Errorf("Snapshot does not exist")
+		}
+
+		return nil
+	}
+}
+
+func testAccPubsubSnapshot_basic(topic, subscription, snapshot, label string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.name}"
+}
+
+resource "google_pubsub_snapshot" "foo" {
+	name         = "%s"
+	subscription = "${google_pubsub_subscription.foo.name}"
+
+	labels {
+		foo = "%s"
+	}
+}`, topic, subscription, snapshot, label)
+}
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/pubsub/v1"
@@ -37,7 +38,41 @@ func resourcePubsubSubscription() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+			},
+
+			"message_retention_duration": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
+			},
+
+			"retain_acked_messages": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"expiration_policy": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// An empty ttl means the subscription never expires.
+						"ttl": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validatePubsubSubscriptionTtl,
+						},
+					},
+				},
+			},
+
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
 			"project": &schema.Schema{
@@ -51,6 +86,31 @@ func resourcePubsubSubscription() *schema.Resource {
 				Computed: true,
 			},
 
+			// seek isn't returned by the API; it's applied on create and
+			// whenever it changes.
+			"seek": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ConflictsWith:    []string{"seek.0.time"},
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+
+						"time": &schema.Schema{
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"seek.0.snapshot"},
+							ValidateFunc:  validateRFC3339Timestamp,
+						},
+					},
+				},
+			},
+
 			"push_config": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -67,6 +127,25 @@ func resourcePubsubSubscription() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+
+						"oidc_token": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_account_email": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"audience": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -93,9 +172,13 @@ func resourcePubsubSubscriptionCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	subscription := &pubsub.Subscription{
-		AckDeadlineSeconds: ackDeadlineSeconds,
-		Topic:              computed_topic_name,
-		PushConfig:         expandPubsubSubscriptionPushConfig(d.Get("push_config").([]interface{})),
+		AckDeadlineSeconds:       ackDeadlineSeconds,
+		Topic:                    computed_topic_name,
+		PushConfig:               expandPubsubSubscriptionPushConfig(d.Get("push_config").([]interface{})),
+		MessageRetentionDuration: d.Get("message_retention_duration").(string),
+		RetainAckedMessages:      d.Get("retain_acked_messages").(bool),
+		ExpirationPolicy:         expandPubsubSubscriptionExpirationPolicy(d.Get("expiration_policy").([]interface{})),
+		Labels:                   expandLabels(d),
 	}
 
 	call := config.clientPubsub.Projects.Subscriptions.Create(name, subscription)
@@ -106,6 +189,12 @@ func resourcePubsubSubscriptionCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(res.Name)
 
+	if v, ok := d.GetOk("seek"); ok {
+		if err := seekPubsubSubscription(config, project, res.Name, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourcePubsubSubscriptionRead(d, meta)
 }
 
@@ -120,6 +209,14 @@ func getComputedTopicName(project string, topic string) string {
 	return computed_topic_name
 }
 
+func getComputedSubscriptionName(project string, subscription string) string {
+	match, _ := regexp.MatchString("projects\\/.*\\/subscriptions\\/.*", subscription)
+	if match {
+		return subscription
+	}
+	return fmt.Sprintf("projects/%s/subscriptions/%s", project, subscription)
+}
+
 func resourcePubsubSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -134,6 +231,10 @@ func resourcePubsubSubscriptionRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("ack_deadline_seconds", subscription.AckDeadlineSeconds)
 	d.Set("path", subscription.Name)
 	d.Set("push_config", flattenPubsubSubscriptionPushConfig(subscription.PushConfig))
+	d.Set("message_retention_duration", subscription.MessageRetentionDuration)
+	d.Set("retain_acked_messages", subscription.RetainAckedMessages)
+	d.Set("expiration_policy", flattenPubsubSubscriptionExpirationPolicy(subscription.ExpirationPolicy))
+	d.Set("labels", subscription.Labels)
 
 	return nil
 }
@@ -143,6 +244,64 @@ func resourcePubsubSubscriptionUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 
+	subscription := &pubsub.Subscription{}
+	updateMask := []string{}
+
+	if d.HasChange("ack_deadline_seconds") {
+		updateMask = append(updateMask, "ackDeadlineSeconds")
+		subscription.AckDeadlineSeconds = int64(d.Get("ack_deadline_seconds").(int))
+	}
+
+	if d.HasChange("message_retention_duration") {
+		updateMask = append(updateMask, "messageRetentionDuration")
+		subscription.MessageRetentionDuration = d.Get("message_retention_duration").(string)
+	}
+
+	if d.HasChange("retain_acked_messages") {
+		updateMask = append(updateMask, "retainAckedMessages")
+		subscription.RetainAckedMessages = d.Get("retain_acked_messages").(bool)
+	}
+
+	if d.HasChange("expiration_policy") {
+		updateMask = append(updateMask, "expirationPolicy")
+		subscription.ExpirationPolicy = expandPubsubSubscriptionExpirationPolicy(d.Get("expiration_policy").([]interface{}))
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+		subscription.Labels = expandLabels(d)
+	}
+
+	if len(updateMask) > 0 {
+		_, err := config.clientPubsub.Projects.Subscriptions.Patch(d.Id(), &pubsub.UpdateSubscriptionRequest{
+			Subscription: subscription,
+			UpdateMask:   strings.Join(updateMask, ","),
+		}).Do()
+
+		if err != nil {
+			return fmt.Errorf("Error updating subscription '%s': %s", d.Get("name"), err)
+		}
+
+		d.SetPartial("ack_deadline_seconds")
+		d.SetPartial("message_retention_duration")
+		d.SetPartial("retain_acked_messages")
+		d.SetPartial("expiration_policy")
+		d.SetPartial("labels")
+	}
+
+	if d.HasChange("seek") {
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		if err := seekPubsubSubscription(config, project, d.Id(), d.Get("seek").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("seek")
+	}
+
 	if d.HasChange("push_config") {
 		_, err := config.clientPubsub.Projects.Subscriptions.ModifyPushConfig(d.Id(), &pubsub.ModifyPushConfigRequest{
 			PushConfig: expandPubsubSubscriptionPushConfig(d.Get("push_config").([]interface{})),
@@ -151,11 +310,13 @@ func resourcePubsubSubscriptionUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("Error updating subscription '%s': %s", d.Get("name"), err)
 		}
+
+		d.SetPartial("push_config")
 	}
 
 	d.Partial(false)
 
-	return nil
+	return resourcePubsubSubscriptionRead(d, meta)
 }
 
 func resourcePubsubSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -196,6 +357,7 @@ func flattenPubsubSubscriptionPushConfig(pushConfig *pubsub.PushConfig) []map[st
 	configs = append(configs, map[string]interface{}{
 		"push_endpoint": pushConfig.PushEndpoint,
 		"attributes":    pushConfig.Attributes,
+		"oidc_token":    flattenPubsubSubscriptionOidcToken(pushConfig.OidcToken),
 	})
 
 	return configs
@@ -212,5 +374,90 @@ func expandPubsubSubscriptionPushConfig(configured []interface{}) *pubsub.PushCo
 	return &pubsub.PushConfig{
 		PushEndpoint: pushConfig["push_endpoint"].(string),
 		Attributes:   convertStringMap(pushConfig["attributes"].(map[string]interface{})),
+		OidcToken:    expandPubsubSubscriptionOidcToken(pushConfig["oidc_token"].([]interface{})),
+	}
+}
+
+func flattenPubsubSubscriptionOidcToken(oidcToken *pubsub.OidcToken) []map[string]interface{} {
+	if oidcToken == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"service_account_email": oidcToken.ServiceAccountEmail,
+			"audience":              oidcToken.Audience,
+		},
+	}
+}
+
+func expandPubsubSubscriptionOidcToken(configured []interface{}) *pubsub.OidcToken {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
 	}
+
+	oidcToken := configured[0].(map[string]interface{})
+	return &pubsub.OidcToken{
+		ServiceAccountEmail: oidcToken["service_account_email"].(string),
+		Audience:            oidcToken["audience"].(string),
+	}
+}
+
+func flattenPubsubSubscriptionExpirationPolicy(expirationPolicy *pubsub.ExpirationPolicy) []map[string]interface{} {
+	if expirationPolicy == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"ttl": expirationPolicy.Ttl,
+		},
+	}
+}
+
+func expandPubsubSubscriptionExpirationPolicy(configured []interface{}) *pubsub.ExpirationPolicy {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	// A policy with an empty ttl is sent as {}, which disables expiration.
+	expirationPolicy := &pubsub.ExpirationPolicy{}
+	if configured[0] != nil {
+		expirationPolicy.Ttl = configured[0].(map[string]interface{})["ttl"].(string)
+	}
+
+	return expirationPolicy
+}
+
+func validatePubsubSubscriptionTtl(v interface{}, k string) (ws []string, errors []error) {
+	if v.(string) == "" {
+		return
+	}
+
+	return validateDuration(v, k)
+}
+
+// seekPubsubSubscription moves the subscription's acknowledgement state to the
+// configured snapshot or point in time. Removing the seek block is a no-op.
+func seekPubsubSubscription(config *Config, project, subscription string, configured []interface{}) error {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	seek := configured[0].(map[string]interface{})
+	seekRequest := &pubsub.SeekRequest{}
+	if v := seek["snapshot"].(string); v != "" {
+		seekRequest.Snapshot = getComputedSnapshotName(project, v)
+	} else if v := seek["time"].(string); v != "" {
+		seekRequest.Time = v
+	} else {
+		return fmt.Errorf("one of seek.0.snapshot or seek.0.time must be set")
+	}
+
+	_, err := config.clientPubsub.Projects.Subscriptions.Seek(subscription, seekRequest).Do()
+	if err != nil {
+		return fmt.Errorf("Error seeking subscription '%s': %s", subscription, err)
+	}
+
+	return nil
 }
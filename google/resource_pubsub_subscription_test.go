@@ -2,13 +2,51 @@ package google
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/pubsub/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_pubsub_subscription", &resource.Sweeper{
+		Name: "gcp_pubsub_subscription",
+		F:    testSweepPubsubSubscriptions,
+	})
+}
+
+func testSweepPubsubSubscriptions(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientPubsub.Projects.Subscriptions.List("projects/" + config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing Pub/Sub subscriptions: %s", err)
+		}
+
+		for _, subscription := range found.Subscriptions {
+			fullName := subscription.Name
+			sweepResource("Pub/Sub subscription", GetResourceNameFromSelfLink(fullName), func() error {
+				_, err := config.clientPubsub.Projects.Subscriptions.Delete(fullName).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccPubsubSubscription_basic(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +70,91 @@ func TestAccPubsubSubscription_basic(t *testing.T) {
 	})
 }
 
+func TestAccPubsubSubscription_update(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSubscription_basic(topic, subscription),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubSubscriptionExists("google_pubsub_subscription.foobar_sub"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "message_retention_duration", "604800s"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "expiration_policy.#", "1"),
+				),
+			},
+			{
+				Config: testAccPubsubSubscription_full(topic, subscription, 30, "1200s", "86400s", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubSubscriptionExists("google_pubsub_subscription.foobar_sub"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "ack_deadline_seconds", "30"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "message_retention_duration", "1200s"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "retain_acked_messages", "true"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "expiration_policy.0.ttl", "86400s"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "labels.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccPubsubSubscription_full(topic, subscription, 60, "3600s", "", "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "ack_deadline_seconds", "60"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "message_retention_duration", "3600s"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "expiration_policy.0.ttl", ""),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foobar_sub", "labels.foo", "baz"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foobar_sub",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPubsubSubscription_seek(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+	snapshot := fmt.Sprintf("tf-test-snapshot-%s", acctest.RandString(10))
+	seekTime := time.Now().UTC().Add(-time.Minute).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSubscription_seek(topic, subscription, snapshot, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubSubscriptionExists("google_pubsub_subscription.seeker"),
+				),
+			},
+			{
+				Config: testAccPubsubSubscription_seek(topic, subscription, snapshot, `snapshot = "${google_pubsub_snapshot.foo.name}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubSubscriptionExists("google_pubsub_subscription.seeker"),
+				),
+			},
+			{
+				Config: testAccPubsubSubscription_seek(topic, subscription, snapshot, fmt.Sprintf(`time = "%s"`, seekTime)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubSubscriptionExists("google_pubsub_subscription.seeker"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.seeker", "seek.0.time", seekTime),
+				),
+			},
+		},
+	})
+}
+
 // TODO: Add acceptance test for push delivery.
 //
 // Testing push endpoints is tricky for the following reason:
@@ -93,6 +216,86 @@ resource "google_pubsub_subscription" "foobar_sub" {
 }`, topic, subscription)
 }
 
+func testAccPubsubSubscription_full(topic, subscription string, ackDeadline int, retention, ttl, label string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foobar_sub" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foobar_sub" {
+	name                       = "%s"
+	topic                      = "${google_pubsub_topic.foobar_sub.name}"
+	ack_deadline_seconds       = %d
+	message_retention_duration = "%s"
+	retain_acked_messages      = true
+
+	expiration_policy {
+		ttl = "%s"
+	}
+
+	labels {
+		foo = "%s"
+	}
+}`, topic, subscription, ackDeadline, retention, ttl, label)
+}
+
+func testAccPubsubSubscription_seek(topic, subscription, snapshot, seek string) string {
+	seekBlock := ""
+	if seek != "" {
+		seekBlock = fmt.Sprintf(`
+	seek {
+		%s
+	}`, seek)
+	}
+
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.name}"
+}
+
+resource "google_pubsub_snapshot" "foo" {
+	name         = "%s"
+	subscription = "${google_pubsub_subscription.foo.name}"
+}
+
+resource "google_pubsub_subscription" "seeker" {
+	name                  = "%s-seeker"
+	topic                 = "${google_pubsub_topic.foo.name}"
+	retain_acked_messages = true
+%s
+}`, topic, subscription, snapshot, subscription, seekBlock)
+}
+
+func TestGetComputedSubscriptionName(t *testing.T) {
+	cases := map[string]struct {
+		Project      string
+		Subscription string
+		Expected     string
+	}{
+		"short name": {
+			Project:      "my-project",
+			Subscription: "my-sub",
+			Expected:     "projects/my-project/subscriptions/my-sub",
+		},
+		"full name": {
+			Project:      "my-project",
+			Subscription: "projects/another-project/subscriptions/my-sub",
+			Expected:     "projects/another-project/subscriptions/my-sub",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := getComputedSubscriptionName(tc.Project, tc.Subscription); got != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
 func TestGetComputedTopicName(t *testing.T) {
 	type testData struct {
 		project  string
@@ -120,3 +323,45 @@ func TestGetComputedTopicName(t *testing.T) {
 		}
 	}
 }
+
+func TestPubsubSubscriptionPushConfig_expandFlatten(t *testing.T) {
+	cases := map[string]*pubsub.PushConfig{
+		"endpoint only": {
+			PushEndpoint: "https://example.com/push",
+			Attributes:   map[string]string{},
+		},
+		"oidc token": {
+			PushEndpoint: "https://example.com/push",
+			Attributes:   map[string]string{"x-goog-version": "v1"},
+			OidcToken: &pubsub.OidcToken{
+				ServiceAccountEmail: "pusher@my-project.iam.gserviceaccount.com",
+				Audience:            "https://example.com",
+			},
+		},
+	}
+
+	for tn, pushConfig := range cases {
+		flattened := flattenPubsubSubscriptionPushConfig(pushConfig)
+
+		// Round trip through the same types the schema stores.
+		configured := make([]interface{}, 0, len(flattened))
+		for _, c := range flattened {
+			raw := map[string]interface{}{
+				"push_endpoint": c["push_endpoint"],
+				"attributes":    map[string]interface{}{},
+				"oidc_token":    []interface{}{},
+			}
+			for k, v := range c["attributes"].(map[string]string) {
+				raw["attributes"].(map[string]interface{})[k] = v
+			}
+			for _, token := range c["oidc_token"].([]map[string]interface{}) {
+				raw["oidc_token"] = []interface{}{token}
+			}
+			configured = append(configured, raw)
+		}
+
+		if got := expandPubsubSubscriptionPushConfig(configured); !reflect.DeepEqual(got, pushConfig) {
+			t.Errorf("bad: %s, expected %#v, got %#v", tn, pushConfig, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/pubsub/v1"
@@ -11,6 +12,7 @@ func resourcePubsubTopic() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePubsubTopicCreate,
 		Read:   resourcePubsubTopicRead,
+		Update: resourcePubsubTopicUpdate,
 		Delete: resourcePubsubTopicDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -30,6 +32,35 @@ func resourcePubsubTopic() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+
+			"kms_key_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"message_storage_policy": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_persistence_regions": &schema.Schema{
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -43,7 +74,11 @@ func resourcePubsubTopicCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	name := fmt.Sprintf("projects/%s/topics/%s", project, d.Get("name").(string))
-	topic := &pubsub.Topic{}
+	topic := &pubsub.Topic{
+		KmsKeyName:           d.Get("kms_key_name").(string),
+		Labels:               expandLabels(d),
+		MessageStoragePolicy: expandPubsubTopicMessageStoragePolicy(d.Get("message_storage_policy").([]interface{})),
+	}
 
 	call := config.clientPubsub.Projects.Topics.Create(name, topic)
 	res, err := call.Do()
@@ -53,7 +88,7 @@ func resourcePubsubTopicCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(res.Name)
 
-	return nil
+	return resourcePubsubTopicRead(d, meta)
 }
 
 func resourcePubsubTopicRead(d *schema.ResourceData, meta interface{}) error {
@@ -67,10 +102,43 @@ func resourcePubsubTopicRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("name", GetResourceNameFromSelfLink(res.Name))
+	d.Set("kms_key_name", res.KmsKeyName)
+	d.Set("labels", res.Labels)
+	d.Set("message_storage_policy", flattenPubsubTopicMessageStoragePolicy(res.MessageStoragePolicy))
 
 	return nil
 }
 
+func resourcePubsubTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	topic := &pubsub.Topic{}
+	updateMask := []string{}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+		topic.Labels = expandLabels(d)
+	}
+
+	if d.HasChange("message_storage_policy") {
+		updateMask = append(updateMask, "messageStoragePolicy")
+		topic.MessageStoragePolicy = expandPubsubTopicMessageStoragePolicy(d.Get("message_storage_policy").([]interface{}))
+	}
+
+	if len(updateMask) > 0 {
+		_, err := config.clientPubsub.Projects.Topics.Patch(d.Id(), &pubsub.UpdateTopicRequest{
+			Topic:      topic,
+			UpdateMask: strings.Join(updateMask, ","),
+		}).Do()
+
+		if err != nil {
+			return fmt.Errorf("Error updating topic '%s': %s", d.Get("name"), err)
+		}
+	}
+
+	return resourcePubsubTopicRead(d, meta)
+}
+
 func resourcePubsubTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -98,3 +166,26 @@ func resourcePubsubTopicStateImporter(d *schema.ResourceData, meta interface{})
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func flattenPubsubTopicMessageStoragePolicy(policy *pubsub.MessageStoragePolicy) []map[string]interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"allowed_persistence_regions": schema.NewSet(schema.HashString, convertStringArrToInterface(policy.AllowedPersistenceRegions)),
+		},
+	}
+}
+
+func expandPubsubTopicMessageStoragePolicy(configured []interface{}) *pubsub.MessageStoragePolicy {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	policy := configured[0].(map[string]interface{})
+	return &pubsub.MessageStoragePolicy{
+		AllowedPersistenceRegions: convertStringSet(policy["allowed_persistence_regions"].(*schema.Set)),
+	}
+}
@@ -9,6 +9,42 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_pubsub_topic", &resource.Sweeper{
+		Name:         "gcp_pubsub_topic",
+		Dependencies: []string{"gcp_pubsub_subscription"},
+		F:            testSweepPubsubTopics,
+	})
+}
+
+func testSweepPubsubTopics(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientPubsub.Projects.Topics.List("projects/" + config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing Pub/Sub topics: %s", err)
+		}
+
+		for _, topic := range found.Topics {
+			fullName := topic.Name
+			sweepResource("Pub/Sub topic", GetResourceNameFromSelfLink(fullName), func() error {
+				_, err := config.clientPubsub.Projects.Topics.Delete(fullName).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccPubsubTopicCreate(t *testing.T) {
 	t.Parallel()
 
@@ -28,6 +64,42 @@ func TestAccPubsubTopicCreate(t *testing.T) {
 	})
 }
 
+func TestAccPubsubTopic_update(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPubsubTopic_update(topic, "bar", "us-central1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubTopicExists("google_pubsub_topic.foo"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "labels.foo", "bar"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "message_storage_policy.0.allowed_persistence_regions.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPubsubTopic_update(topic, "baz", "europe-west1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPubsubTopicExists("google_pubsub_topic.foo"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "labels.foo", "baz"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "message_storage_policy.0.allowed_persistence_regions.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckPubsubTopicDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "google_pubsub_topic" {
@@ -68,3 +140,18 @@ var testAccPubsubTopic = fmt.Sprintf(`
 resource "google_pubsub_topic" "foobar" {
 	name = "pstopic-test-%s"
 }`, acctest.RandString(10))
+
+func testAccPubsubTopic_update(topic, label, region string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+
+	labels {
+		foo = "%s"
+	}
+
+	message_storage_policy {
+		allowed_persistence_regions = ["%s"]
+	}
+}`, topic, label, region)
+}
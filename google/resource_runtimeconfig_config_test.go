@@ -10,6 +10,41 @@ import (
 	"google.golang.org/api/runtimeconfig/v1beta1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_runtimeconfig_config", &resource.Sweeper{
+		Name: "gcp_runtimeconfig_config",
+		F:    testSweepRuntimeconfigConfigs,
+	})
+}
+
+func testSweepRuntimeconfigConfigs(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientRuntimeconfig.Projects.Configs.List("projects/" + config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing runtimeconfig configs: %s", err)
+		}
+
+		for _, runtimeConfig := range found.Configs {
+			fullName := runtimeConfig.Name
+			sweepResource("runtimeconfig config", GetResourceNameFromSelfLink(fullName), func() error {
+				_, err := config.clientRuntimeconfig.Projects.Configs.Delete(fullName).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccRuntimeconfigConfig_basic(t *testing.T) {
 	t.Parallel()
 
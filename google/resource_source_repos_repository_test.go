@@ -9,6 +9,41 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_sourcerepo_repository", &resource.Sweeper{
+		Name: "gcp_sourcerepo_repository",
+		F:    testSweepSourceRepoRepositories,
+	})
+}
+
+func testSweepSourceRepoRepositories(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientSourceRepo.Projects.Repos.List("projects/" + config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing source repositories: %s", err)
+		}
+
+		for _, repo := range found.Repos {
+			fullName := repo.Name
+			sweepResource("source repository", GetResourceNameFromSelfLink(fullName), func() error {
+				_, err := config.clientSourceRepo.Projects.Repos.Delete(fullName).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccSourceRepoRepository_basic(t *testing.T) {
 	t.Parallel()
 
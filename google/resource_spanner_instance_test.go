@@ -15,6 +15,41 @@ import (
 	"strings"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_spanner_instance", &resource.Sweeper{
+		Name: "gcp_spanner_instance",
+		F:    testSweepSpannerInstances,
+	})
+}
+
+func testSweepSpannerInstances(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientSpanner.Projects.Instances.List("projects/" + config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing Spanner instances: %s", err)
+		}
+
+		for _, instance := range found.Instances {
+			fullName := instance.Name
+			sweepResource("Spanner instance", GetResourceNameFromSelfLink(fullName), func() error {
+				_, err := config.clientSpanner.Projects.Instances.Delete(fullName).Do()
+				return err
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 // Unit Tests
 
 func TestExtractInstanceConfigFromUri_withFullPath(t *testing.T) {
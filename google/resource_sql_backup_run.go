@@ -0,0 +1,237 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/sqladmin/v1beta4"
+)
+
+func resourceSqlBackupRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSqlBackupRunCreate,
+		Read:   resourceSqlBackupRunRead,
+		Delete: resourceSqlBackupRunDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"backup_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"end_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enqueued_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"start_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSqlBackupRunCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance := d.Get("instance").(string)
+	description := d.Get("description").(string)
+
+	mutexKV.Lock(instanceMutexKey(project, instance))
+	defer mutexKV.Unlock(instanceMutexKey(project, instance))
+	op, err := config.clientSqlAdmin.BackupRuns.Insert(project, instance, &sqladmin.BackupRun{
+		Description: description,
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error, failed to create backup run for instance %s: %s", instance, err)
+	}
+
+	timeoutInMinutes := int(d.Timeout(schema.TimeoutCreate).Minutes())
+	err = sqladminOperationWaitTime(config, op, project, "Create Backup Run", timeoutInMinutes)
+	if err != nil {
+		return fmt.Errorf("Error, failure waiting for backup run of %s: %s", instance, err)
+	}
+
+	// The insert operation doesn't reference the backup run it created, so
+	// look for the on-demand run enqueued by it.
+	backupRuns, err := config.clientSqlAdmin.BackupRuns.List(project, instance).Do()
+	if err != nil {
+		return fmt.Errorf("Error, failed to list backup runs for instance %s: %s", instance, err)
+	}
+
+	backupRun, err := findSqlBackupRun(backupRuns.Items, description, op.InsertTime)
+	if err != nil {
+		return fmt.Errorf("Error, unable to find the backup run created for instance %s: %s", instance, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", instance, backupRun.Id))
+
+	return resourceSqlBackupRunRead(d, meta)
+}
+
+func resourceSqlBackupRunRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance, id, err := parseSqlBackupRunId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	backupRun, err := config.clientSqlAdmin.BackupRuns.Get(project, instance, id).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SQL Backup Run %d in instance %q", id, instance))
+	}
+
+	d.Set("instance", backupRun.Instance)
+	d.Set("project", project)
+	d.Set("backup_id", strconv.FormatInt(backupRun.Id, 10))
+	d.Set("description", backupRun.Description)
+	d.Set("end_time", backupRun.EndTime)
+	d.Set("enqueued_time", backupRun.EnqueuedTime)
+	d.Set("self_link", backupRun.SelfLink)
+	d.Set("start_time", backupRun.StartTime)
+	d.Set("status", backupRun.Status)
+	d.Set("type", backupRun.Type)
+
+	return nil
+}
+
+func resourceSqlBackupRunDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance, id, err := parseSqlBackupRunId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	mutexKV.Lock(instanceMutexKey(project, instance))
+	defer mutexKV.Unlock(instanceMutexKey(project, instance))
+	op, err := config.clientSqlAdmin.BackupRuns.Delete(project, instance, id).Do()
+	if err != nil {
+		return fmt.Errorf("Error, failed to delete backup run %d in instance %s: %s", id, instance, err)
+	}
+
+	timeoutInMinutes := int(d.Timeout(schema.TimeoutDelete).Minutes())
+	err = sqladminOperationWaitTime(config, op, project, "Delete Backup Run", timeoutInMinutes)
+	if err != nil {
+		return fmt.Errorf("Error, failure waiting for deletion of backup run %d in %s: %s", id, instance, err)
+	}
+
+	return nil
+}
+
+// findSqlBackupRun returns the on-demand backup run with the given description that
+// was enqueued at or after insertTime. Backup runs can be started by other clients
+// too, so more than one match is an error rather than a guess.
+func findSqlBackupRun(runs []*sqladmin.BackupRun, description, insertTime string) (*sqladmin.BackupRun, error) {
+	inserted, err := time.Parse(time.RFC3339Nano, insertTime)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid operation insert time %q: %s", insertTime, err)
+	}
+
+	var found *sqladmin.BackupRun
+	for _, run := range runs {
+		if run.Type != "ON_DEMAND" || run.Description != description {
+			continue
+		}
+
+		enqueued, err := time.Parse(time.RFC3339Nano, run.EnqueuedTime)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid enqueued time %q for backup run %d: %s", run.EnqueuedTime, run.Id, err)
+		}
+		if enqueued.Before(inserted) {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("backup runs %d and %d both match description %q, enqueued after %s", found.Id, run.Id, description, insertTime)
+		}
+		found = run
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no on-demand backup run with description %q enqueued after %s", description, insertTime)
+	}
+
+	return found, nil
+}
+
+func parseSqlBackupRunId(id string) (string, int64, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", 0, fmt.Errorf("Invalid SQL backup run id %q, expected format: $INSTANCENAME/$BACKUPID", id)
+	}
+
+	backupId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("Invalid SQL backup run id %q: %s", id, err)
+	}
+
+	return parts[0], backupId, nil
+}
@@ -0,0 +1,150 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/sqladmin/v1beta4"
+)
+
+func TestFindSqlBackupRun(t *testing.T) {
+	insertTime := "2018-03-01T10:00:05.123Z"
+	older := &sqladmin.BackupRun{Id: 1, Type: "ON_DEMAND", Description: "nightly", EnqueuedTime: "2018-03-01T09:00:00Z"}
+	created := &sqladmin.BackupRun{Id: 2, Type: "ON_DEMAND", Description: "nightly", EnqueuedTime: "2018-03-01T10:00:05.5Z"}
+	concurrent := &sqladmin.BackupRun{Id: 3, Type: "ON_DEMAND", Description: "nightly", EnqueuedTime: "2018-03-01T10:00:06Z"}
+	automated := &sqladmin.BackupRun{Id: 4, Type: "AUTOMATED", Description: "nightly", EnqueuedTime: "2018-03-01T10:00:07Z"}
+	otherDescription := &sqladmin.BackupRun{Id: 5, Type: "ON_DEMAND", Description: "weekly", EnqueuedTime: "2018-03-01T10:00:07Z"}
+	// Sorts after insertTime as a string, but was enqueued before it.
+	justBefore := &sqladmin.BackupRun{Id: 6, Type: "ON_DEMAND", Description: "nightly", EnqueuedTime: "2018-03-01T10:00:05Z"}
+
+	cases := map[string]struct {
+		Runs       []*sqladmin.BackupRun
+		ExpectedId int64
+		ExpectErr  bool
+	}{
+		"only the created run": {
+			Runs:       []*sqladmin.BackupRun{created, older, automated, otherDescription},
+			ExpectedId: 2,
+		},
+		"fractional seconds": {
+			Runs:       []*sqladmin.BackupRun{justBefore, created},
+			ExpectedId: 2,
+		},
+		"run enqueued before the operation": {
+			Runs:      []*sqladmin.BackupRun{justBefore, older},
+			ExpectErr: true,
+		},
+		"concurrent run with the same description": {
+			Runs:      []*sqladmin.BackupRun{concurrent, created},
+			ExpectErr: true,
+		},
+		"no runs": {
+			ExpectErr: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		run, err := findSqlBackupRun(tc.Runs, "nightly", insertTime)
+		if tc.ExpectErr {
+			if err == nil {
+				t.Errorf("bad: %s, expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error: %s", tn, err)
+			continue
+		}
+		if run.Id != tc.ExpectedId {
+			t.Errorf("bad: %s, expected backup run %d, got %d", tn, tc.ExpectedId, run.Id)
+		}
+	}
+}
+
+func TestAccSqlBackupRun_basic(t *testing.T) {
+	t.Parallel()
+
+	instance := acctest.RandomWithPrefix("i")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSqlBackupRunDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleSqlBackupRun_basic(instance),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlBackupRunExists("google_sql_backup_run.backup"),
+					resource.TestCheckResourceAttr("google_sql_backup_run.backup", "type", "ON_DEMAND"),
+					resource.TestCheckResourceAttr("google_sql_backup_run.backup", "status", "SUCCESSFUL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleSqlBackupRunExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		instance, id, err := parseSqlBackupRunId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.clientSqlAdmin.BackupRuns.Get(config.Project, instance, id).Do()
+		if err != nil {
+			return fmt.Errorf("Not found: %s: %s", n, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccSqlBackupRunDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		config := testAccProvider.Meta().(*Config)
+		if rs.Type != "google_sql_backup_run" {
+			continue
+		}
+
+		instance, id, err := parseSqlBackupRunId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		backupRun, _ := config.clientSqlAdmin.BackupRuns.Get(config.Project, instance, id).Do()
+		if backupRun != nil {
+			return fmt.Errorf("Backup run %s still exists, should have been destroyed", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testGoogleSqlBackupRun_basic(instance string) string {
+	return fmt.Sprintf(`
+resource "google_sql_database_instance" "instance" {
+	name = "%s"
+	region = "us-central1"
+	settings {
+		tier = "db-f1-micro"
+	}
+}
+
+resource "google_sql_backup_run" "backup" {
+	instance = "${google_sql_database_instance.instance.name}"
+	description = "terraform test backup"
+
+	timeouts {
+		create = "20m"
+	}
+}
+`, instance)
+}
@@ -56,6 +56,13 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"availability_type": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppressFirstGen,
+							ValidateFunc:     validation.StringInSlice([]string{"REGIONAL", "ZONAL"}, false),
+						},
 						"backup_configuration": &schema.Schema{
 							Type:     schema.TypeList,
 							Optional: true,
@@ -71,6 +78,10 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 										Type:     schema.TypeBool,
 										Optional: true,
 									},
+									"point_in_time_recovery_enabled": &schema.Schema{
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
 									"start_time": &schema.Schema{
 										Type:     schema.TypeString,
 										Optional: true,
@@ -153,6 +164,11 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 										// Defaults differ between first and second gen instances
 										Computed: true,
 									},
+									"private_network": &schema.Schema{
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: compareSelfLinkOrResourceName,
+									},
 									"require_ssl": &schema.Schema{
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -220,6 +236,40 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"service_account_email_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"server_ca_cert": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cert": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"common_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiration_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sha1_fingerprint": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"database_version": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -372,8 +422,7 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	_settings := _settingsList[0].(map[string]interface{})
 	settings := &sqladmin.Settings{
-		Tier:            _settings["tier"].(string),
-		ForceSendFields: []string{"StorageAutoResize"},
+		Tier: _settings["tier"].(string),
 	}
 
 	if v, ok := _settings["activation_policy"]; ok {
@@ -403,6 +452,10 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 				settings.BackupConfiguration.Enabled = vp.(bool)
 			}
 
+			if vp, okp := _backupConfiguration["point_in_time_recovery_enabled"]; okp {
+				settings.BackupConfiguration.PointInTimeRecoveryEnabled = vp.(bool)
+			}
+
 			if vp, okp := _backupConfiguration["start_time"]; okp {
 				settings.BackupConfiguration.StartTime = vp.(string)
 			}
@@ -413,7 +466,7 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		settings.CrashSafeReplicationEnabled = v.(bool)
 	}
 
-	settings.StorageAutoResize = _settings["disk_autoresize"].(bool)
+	settings.StorageAutoResize = googleapi.Bool(_settings["disk_autoresize"].(bool))
 
 	if v, ok := _settings["disk_size"]; ok && v.(int) > 0 {
 		settings.DataDiskSizeGb = int64(v.(int))
@@ -452,6 +505,14 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 				settings.IpConfiguration.Ipv4Enabled = vp.(bool)
 			}
 
+			if vp, okp := _ipConfiguration["private_network"]; okp && vp.(string) != "" {
+				network, err := ParseNetworkFieldValue(vp.(string), d, config)
+				if err != nil {
+					return err
+				}
+				settings.IpConfiguration.PrivateNetwork = network.RelativeLink()
+			}
+
 			if vp, okp := _ipConfiguration["require_ssl"]; okp {
 				settings.IpConfiguration.RequireSsl = vp.(bool)
 			}
@@ -526,6 +587,10 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		settings.ReplicationType = v.(string)
 	}
 
+	if v, ok := _settings["availability_type"]; ok {
+		settings.AvailabilityType = v.(string)
+	}
+
 	instance := &sqladmin.DatabaseInstance{
 		Region:          region,
 		Settings:        settings,
@@ -636,7 +701,7 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		for _, u := range users.Items {
 			if u.Name == "root" && u.Host == "%" {
 				err = retry(func() error {
-					op, err = config.clientSqlAdmin.Users.Delete(project, instance.Name, u.Host, u.Name).Do()
+					op, err = config.clientSqlAdmin.Users.Delete(project, instance.Name).Host(u.Host).Name(u.Name).Do()
 					if err == nil {
 						err = sqladminOperationWait(config, op, project, "Delete default root User")
 					}
@@ -671,6 +736,7 @@ func resourceSqlDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("region", instance.Region)
 	d.Set("database_version", instance.DatabaseVersion)
 	d.Set("connection_name", instance.ConnectionName)
+	d.Set("service_account_email_address", instance.ServiceAccountEmailAddress)
 
 	if err := d.Set("settings", flattenSettings(instance.Settings)); err != nil {
 		log.Printf("[WARN] Failed to set SQL Database Instance Settings")
@@ -682,6 +748,10 @@ func resourceSqlDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[WARN] Failed to set SQL Database Instance IP Addresses")
 	}
 
+	if err := d.Set("server_ca_cert", flattenServerCaCert(instance.ServerCaCert)); err != nil {
+		log.Printf("[WARN] Failed to set SQL Database CA Certificate")
+	}
+
 	d.Set("master_instance_name", strings.TrimPrefix(instance.MasterInstanceName, project+":"))
 
 	d.Set("self_link", instance.SelfLink)
@@ -718,8 +788,7 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		settings := &sqladmin.Settings{
 			Tier:              _settings["tier"].(string),
 			SettingsVersion:   instance.Settings.SettingsVersion,
-			StorageAutoResize: _settings["disk_autoresize"].(bool),
-			ForceSendFields:   []string{"StorageAutoResize"},
+			StorageAutoResize: googleapi.Bool(_settings["disk_autoresize"].(bool)),
 		}
 
 		if v, ok := _settings["activation_policy"]; ok {
@@ -749,6 +818,10 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 					settings.BackupConfiguration.Enabled = vp.(bool)
 				}
 
+				if vp, okp := _backupConfiguration["point_in_time_recovery_enabled"]; okp {
+					settings.BackupConfiguration.PointInTimeRecoveryEnabled = vp.(bool)
+				}
+
 				if vp, okp := _backupConfiguration["start_time"]; okp {
 					settings.BackupConfiguration.StartTime = vp.(string)
 				}
@@ -825,6 +898,14 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 					settings.IpConfiguration.Ipv4Enabled = vp.(bool)
 				}
 
+				if vp, okp := _ipConfiguration["private_network"]; okp && vp.(string) != "" {
+					network, err := ParseNetworkFieldValue(vp.(string), d, config)
+					if err != nil {
+						return err
+					}
+					settings.IpConfiguration.PrivateNetwork = network.RelativeLink()
+				}
+
 				if vp, okp := _ipConfiguration["require_ssl"]; okp {
 					settings.IpConfiguration.RequireSsl = vp.(bool)
 				}
@@ -935,6 +1016,10 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 			settings.ReplicationType = v.(string)
 		}
 
+		if v, ok := _settings["availability_type"]; ok {
+			settings.AvailabilityType = v.(string)
+		}
+
 		instance.Settings = settings
 	}
 
@@ -981,14 +1066,18 @@ func flattenSettings(settings *sqladmin.Settings) []map[string]interface{} {
 		"tier":                        settings.Tier,
 		"activation_policy":           settings.ActivationPolicy,
 		"authorized_gae_applications": settings.AuthorizedGaeApplications,
+		"availability_type":           settings.AvailabilityType,
 		"crash_safe_replication":      settings.CrashSafeReplicationEnabled,
-		"disk_autoresize":             settings.StorageAutoResize,
 		"disk_type":                   settings.DataDiskType,
 		"disk_size":                   settings.DataDiskSizeGb,
 		"pricing_plan":                settings.PricingPlan,
 		"replication_type":            settings.ReplicationType,
 	}
 
+	if settings.StorageAutoResize != nil {
+		data["disk_autoresize"] = *settings.StorageAutoResize
+	}
+
 	if settings.BackupConfiguration != nil {
 		data["backup_configuration"] = flattenBackupConfiguration(settings.BackupConfiguration)
 	}
@@ -1013,9 +1102,10 @@ func flattenSettings(settings *sqladmin.Settings) []map[string]interface{} {
 
 func flattenBackupConfiguration(backupConfiguration *sqladmin.BackupConfiguration) []map[string]interface{} {
 	data := map[string]interface{}{
-		"binary_log_enabled": backupConfiguration.BinaryLogEnabled,
-		"enabled":            backupConfiguration.Enabled,
-		"start_time":         backupConfiguration.StartTime,
+		"binary_log_enabled":             backupConfiguration.BinaryLogEnabled,
+		"enabled":                        backupConfiguration.Enabled,
+		"point_in_time_recovery_enabled": backupConfiguration.PointInTimeRecoveryEnabled,
+		"start_time":                     backupConfiguration.StartTime,
 	}
 
 	return []map[string]interface{}{data}
@@ -1038,8 +1128,9 @@ func flattenDatabaseFlags(databaseFlags []*sqladmin.DatabaseFlags) []map[string]
 
 func flattenIpConfiguration(ipConfiguration *sqladmin.IpConfiguration) interface{} {
 	data := map[string]interface{}{
-		"ipv4_enabled": ipConfiguration.Ipv4Enabled,
-		"require_ssl":  ipConfiguration.RequireSsl,
+		"ipv4_enabled":    ipConfiguration.Ipv4Enabled,
+		"private_network": ipConfiguration.PrivateNetwork,
+		"require_ssl":     ipConfiguration.RequireSsl,
 	}
 
 	if ipConfiguration.AuthorizedNetworks != nil {
@@ -1116,6 +1207,24 @@ func flattenIpAddresses(ipAddresses []*sqladmin.IpMapping) []map[string]interfac
 	return ips
 }
 
+func flattenServerCaCert(caCert *sqladmin.SslCert) []map[string]interface{} {
+	var cert []map[string]interface{}
+
+	if caCert != nil {
+		data := map[string]interface{}{
+			"cert":             caCert.Cert,
+			"common_name":      caCert.CommonName,
+			"create_time":      caCert.CreateTime,
+			"expiration_time":  caCert.ExpirationTime,
+			"sha1_fingerprint": caCert.Sha1Fingerprint,
+		}
+
+		cert = append(cert, data)
+	}
+
+	return cert
+}
+
 func instanceMutexKey(project, instance_name string) string {
 	return fmt.Sprintf("google-sql-database-instance-%s-%s", project, instance_name)
 }
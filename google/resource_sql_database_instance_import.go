@@ -0,0 +1,179 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/sqladmin/v1beta4"
+)
+
+func resourceSqlDatabaseInstanceImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSqlDatabaseInstanceImportCreate,
+		Read:   resourceSqlDatabaseInstanceImportRead,
+		Delete: resourceSqlDatabaseInstanceImportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"uri": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^gs://.+`),
+			},
+
+			"csv_import_options": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"table": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"columns": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"database": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"file_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SQL",
+				ValidateFunc: validation.StringInSlice([]string{"SQL", "CSV"}, false),
+			},
+
+			"import_user": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSqlDatabaseInstanceImportCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance := d.Get("instance").(string)
+	uri := d.Get("uri").(string)
+	fileType := d.Get("file_type").(string)
+
+	importContext := &sqladmin.ImportContext{
+		Uri:        uri,
+		Database:   d.Get("database").(string),
+		FileType:   fileType,
+		ImportUser: d.Get("import_user").(string),
+	}
+
+	if v, ok := d.GetOk("csv_import_options"); ok {
+		if fileType != "CSV" {
+			return fmt.Errorf("csv_import_options can only be set when file_type is CSV")
+		}
+		importContext.CsvImportOptions = expandSqlCsvImportOptions(v.([]interface{}))
+	}
+
+	if fileType == "CSV" && importContext.Database == "" {
+		return fmt.Errorf("database must be set when file_type is CSV")
+	}
+
+	mutexKV.Lock(instanceMutexKey(project, instance))
+	defer mutexKV.Unlock(instanceMutexKey(project, instance))
+	op, err := config.clientSqlAdmin.Instances.Import(project, instance, &sqladmin.InstancesImportRequest{
+		ImportContext: importContext,
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error, failed to import %s into instance %s: %s", uri, instance, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instance, op.Name))
+
+	timeoutInMinutes := int(d.Timeout(schema.TimeoutCreate).Minutes())
+	err = sqladminOperationWaitTime(config, op, project, "Import Database", timeoutInMinutes)
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("Error, failure waiting for import of %s into %s: %s", uri, instance, err)
+	}
+
+	return resourceSqlDatabaseInstanceImportRead(d, meta)
+}
+
+func resourceSqlDatabaseInstanceImportRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	// An import is a one-off operation, so there's nothing to refresh beyond
+	// checking that the instance it was loaded into still exists.
+	instance := d.Get("instance").(string)
+	_, err = config.clientSqlAdmin.Instances.Get(project, instance).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SQL Database Instance %q", instance))
+	}
+
+	d.Set("project", project)
+
+	return nil
+}
+
+func resourceSqlDatabaseInstanceImportDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Removing SQL import %q from state; the imported data is left in instance %q",
+		d.Id(), d.Get("instance").(string))
+	d.SetId("")
+
+	return nil
+}
+
+func expandSqlCsvImportOptions(configured []interface{}) *sqladmin.ImportContextCsvImportOptions {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	return &sqladmin.ImportContextCsvImportOptions{
+		Table:   data["table"].(string),
+		Columns: convertStringArr(data["columns"].([]interface{})),
+	}
+}
@@ -0,0 +1,193 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSqlDatabaseInstanceImport_sql(t *testing.T) {
+	t.Parallel()
+
+	instance := acctest.RandomWithPrefix("i")
+	bucket := acctest.RandomWithPrefix("tf-test-sql-import")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleSqlDatabaseInstanceImport_sql(instance, bucket),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceImportSucceeded("google_sql_database_instance_import.import"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSqlDatabaseInstanceImport_csv(t *testing.T) {
+	t.Parallel()
+
+	instance := acctest.RandomWithPrefix("i")
+	bucket := acctest.RandomWithPrefix("tf-test-sql-import")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleSqlDatabaseInstanceImport_csv(instance, bucket),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceImportSucceeded("google_sql_database_instance_import.csv"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckGoogleSqlDatabaseInstanceImportSucceeded checks that the import
+// operation for the resource's uri completed without errors.
+func testAccCheckGoogleSqlDatabaseInstanceImportSucceeded(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		instance := rs.Primary.Attributes["instance"]
+		ops, err := config.clientSqlAdmin.Operations.List(config.Project).Instance(instance).Do()
+		if err != nil {
+			return err
+		}
+
+		for _, op := range ops.Items {
+			if op.OperationType == "IMPORT" && op.ImportContext != nil && op.ImportContext.Uri == rs.Primary.Attributes["uri"] {
+				if op.Error != nil {
+					return fmt.Errorf("Import of %s failed: %s", n, SqlAdminOperationError(*op.Error))
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("No import operation found for %s", n)
+	}
+}
+
+func testGoogleSqlDatabaseInstanceImport_sql(instance, bucket string) string {
+	return fmt.Sprintf(`
+resource "google_sql_database_instance" "instance" {
+	name = "%s"
+	region = "us-central1"
+	settings {
+		tier = "db-f1-micro"
+	}
+}
+
+resource "google_sql_database" "database" {
+	name = "db"
+	instance = "${google_sql_database_instance.instance.name}"
+}
+
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	force_destroy = true
+}
+
+resource "google_storage_bucket_object" "dump" {
+	name = "dump.sql"
+	bucket = "${google_storage_bucket.bucket.name}"
+	content = "CREATE TABLE test_table (id INT PRIMARY KEY);"
+}
+
+resource "google_storage_object_acl" "dump" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	object = "${google_storage_bucket_object.dump.name}"
+	role_entity = ["READER:user-${google_sql_database_instance.instance.service_account_email_address}"]
+}
+
+resource "google_sql_database_instance_import" "import" {
+	instance = "${google_sql_database_instance.instance.name}"
+	database = "${google_sql_database.database.name}"
+	uri = "gs://${google_storage_bucket.bucket.name}/${google_storage_bucket_object.dump.name}"
+
+	depends_on = ["google_storage_object_acl.dump"]
+}
+`, instance, bucket)
+}
+
+func testGoogleSqlDatabaseInstanceImport_csv(instance, bucket string) string {
+	return fmt.Sprintf(`
+resource "google_sql_database_instance" "instance" {
+	name = "%s"
+	region = "us-central1"
+	settings {
+		tier = "db-f1-micro"
+	}
+}
+
+resource "google_sql_database" "database" {
+	name = "db"
+	instance = "${google_sql_database_instance.instance.name}"
+}
+
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	force_destroy = true
+}
+
+resource "google_storage_bucket_object" "schema" {
+	name = "schema.sql"
+	bucket = "${google_storage_bucket.bucket.name}"
+	content = "CREATE TABLE test_table (id INT PRIMARY KEY, name VARCHAR(16));"
+}
+
+resource "google_storage_bucket_object" "rows" {
+	name = "rows.csv"
+	bucket = "${google_storage_bucket.bucket.name}"
+	content = "1,one\n2,two\n"
+}
+
+resource "google_storage_object_acl" "schema" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	object = "${google_storage_bucket_object.schema.name}"
+	role_entity = ["READER:user-${google_sql_database_instance.instance.service_account_email_address}"]
+}
+
+resource "google_storage_object_acl" "rows" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	object = "${google_storage_bucket_object.rows.name}"
+	role_entity = ["READER:user-${google_sql_database_instance.instance.service_account_email_address}"]
+}
+
+resource "google_sql_database_instance_import" "schema" {
+	instance = "${google_sql_database_instance.instance.name}"
+	database = "${google_sql_database.database.name}"
+	uri = "gs://${google_storage_bucket.bucket.name}/${google_storage_bucket_object.schema.name}"
+
+	depends_on = ["google_storage_object_acl.schema"]
+}
+
+resource "google_sql_database_instance_import" "csv" {
+	instance = "${google_sql_database_instance.instance.name}"
+	database = "${google_sql_database.database.name}"
+	uri = "gs://${google_storage_bucket.bucket.name}/${google_storage_bucket_object.rows.name}"
+	file_type = "CSV"
+
+	csv_import_options {
+		table = "test_table"
+		columns = ["id", "name"]
+	}
+
+	timeouts {
+		create = "15m"
+	}
+
+	depends_on = ["google_storage_object_acl.rows", "google_sql_database_instance_import.schema"]
+}
+`, instance, bucket)
+}
@@ -10,6 +10,7 @@ package google
 import (
 	"fmt"
 	"log"
+	"os"
 	"strconv"
 	"strings"
 	"testing"
@@ -39,26 +40,32 @@ func testSweepDatabases(region string) error {
 		log.Fatalf("error loading: %s", err)
 	}
 
-	found, err := config.clientSqlAdmin.Instances.List(config.Project).Do()
-	if err != nil {
-		log.Fatalf("error listing databases: %s", err)
+	var instances []*sqladmin.DatabaseInstance
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientSqlAdmin.Instances.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			log.Fatalf("error listing databases: %s", err)
+		}
+
+		instances = append(instances, found.Items...)
+		token = found.NextPageToken
+		paginate = token != ""
 	}
 
-	if len(found.Items) == 0 {
+	if len(instances) == 0 {
 		log.Printf("No databases found")
 		return nil
 	}
 
-	for _, d := range found.Items {
-		var testDbInstance bool
-		for _, testName := range []string{"tf-lw-", "sqldatabasetest"} {
-			// only destroy instances we know to fit our test naming pattern
-			if strings.HasPrefix(d.Name, testName) {
-				testDbInstance = true
-			}
+	for _, d := range instances {
+		// only destroy instances we know to fit our test naming pattern
+		if !isSweepableTestResource(d.Name) {
+			continue
 		}
 
-		if !testDbInstance {
+		if *sweepDryRun {
+			log.Printf("[INFO] Would delete SQL Instance %q and its replicas %v", d.Name, d.ReplicaNames)
 			continue
 		}
 
@@ -324,6 +331,111 @@ func TestAccGoogleSqlDatabaseInstance_diskspecs(t *testing.T) {
 	})
 }
 
+func TestAccGoogleSqlDatabaseInstance_postgresHighAvailability(t *testing.T) {
+	t.Parallel()
+
+	var instance sqladmin.DatabaseInstance
+	databaseID := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_postgresHighAvailability, databaseID, "REGIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceExists(
+						"google_sql_database_instance.instance", &instance),
+					testAccCheckGoogleSqlDatabaseInstanceEquals(
+						"google_sql_database_instance.instance", &instance),
+					resource.TestCheckResourceAttr(
+						"google_sql_database_instance.instance", "settings.0.availability_type", "REGIONAL"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_postgresHighAvailability, databaseID, "ZONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceExists(
+						"google_sql_database_instance.instance", &instance),
+					testAccCheckGoogleSqlDatabaseInstanceEquals(
+						"google_sql_database_instance.instance", &instance),
+					resource.TestCheckResourceAttr(
+						"google_sql_database_instance.instance", "settings.0.availability_type", "ZONAL"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGoogleSqlDatabaseInstance_mysqlPointInTimeRecovery(t *testing.T) {
+	t.Parallel()
+
+	var instance sqladmin.DatabaseInstance
+	databaseID := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_mysqlPointInTimeRecovery, databaseID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceExists(
+						"google_sql_database_instance.instance", &instance),
+					testAccCheckGoogleSqlDatabaseInstanceEquals(
+						"google_sql_database_instance.instance", &instance),
+					resource.TestCheckResourceAttr(
+						"google_sql_database_instance.instance", "settings.0.backup_configuration.0.binary_log_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGoogleSqlDatabaseInstance_postgresPointInTimeRecovery(t *testing.T) {
+	t.Parallel()
+
+	var instance sqladmin.DatabaseInstance
+	databaseID := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_postgresPointInTimeRecovery, databaseID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceExists(
+						"google_sql_database_instance.instance", &instance),
+					testAccCheckGoogleSqlDatabaseInstanceEquals(
+						"google_sql_database_instance.instance", &instance),
+					resource.TestCheckResourceAttr(
+						"google_sql_database_instance.instance", "settings.0.backup_configuration.0.point_in_time_recovery_enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_postgresPointInTimeRecovery, databaseID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceExists(
+						"google_sql_database_instance.instance", &instance),
+					testAccCheckGoogleSqlDatabaseInstanceEquals(
+						"google_sql_database_instance.instance", &instance),
+					resource.TestCheckResourceAttr(
+						"google_sql_database_instance.instance", "settings.0.backup_configuration.0.point_in_time_recovery_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGoogleSqlDatabaseInstance_maintenance(t *testing.T) {
 	t.Parallel()
 
@@ -448,6 +560,38 @@ func TestAccGoogleSqlDatabaseInstance_authNets(t *testing.T) {
 	})
 }
 
+// Tests that private_network round-trips without a diff. The network must already be
+// peered with the service networking API, which this provider can't set up yet.
+func TestAccGoogleSqlDatabaseInstance_privateNetwork(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_SQL_PRIVATE_NETWORK")
+
+	var instance sqladmin.DatabaseInstance
+	databaseID := acctest.RandInt()
+	networkName := os.Getenv("GOOGLE_SQL_PRIVATE_NETWORK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_privateNetwork, networkName, databaseID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlDatabaseInstanceExists(
+						"google_sql_database_instance.instance", &instance),
+					testAccCheckGoogleSqlDatabaseInstanceEquals(
+						"google_sql_database_instance.instance", &instance),
+					testAccCheckGoogleSqlDatabaseInstancePrivateNetwork(
+						"google_sql_database_instance.instance", "data.google_compute_network.network"),
+				),
+			},
+		},
+	})
+}
+
 // Tests that a SQL instance can be referenced from more than one other resource without
 // throwing an error during provisioning, see #9018.
 func TestAccGoogleSqlDatabaseInstance_multipleOperations(t *testing.T) {
@@ -517,6 +661,12 @@ func testAccCheckGoogleSqlDatabaseInstanceEquals(n string,
 			}
 		}
 
+		server = instance.Settings.AvailabilityType
+		local = attributes["settings.0.availability_type"]
+		if server != local && len(server) > 0 && len(local) > 0 {
+			return fmt.Errorf("Error settings.availability_type mismatch, (%s, %s)", server, local)
+		}
+
 		server = instance.Settings.ActivationPolicy
 		local = attributes["settings.0.activation_policy"]
 		if server != local && len(server) > 0 && len(local) > 0 {
@@ -536,6 +686,12 @@ func testAccCheckGoogleSqlDatabaseInstanceEquals(n string,
 				return fmt.Errorf("Error settings.backup_configuration.enabled mismatch, (%s, %s)", server, local)
 			}
 
+			server = strconv.FormatBool(instance.Settings.BackupConfiguration.PointInTimeRecoveryEnabled)
+			local = attributes["settings.0.backup_configuration.0.point_in_time_recovery_enabled"]
+			if server != local && len(server) > 0 && len(local) > 0 {
+				return fmt.Errorf("Error settings.backup_configuration.point_in_time_recovery_enabled mismatch, (%s, %s)", server, local)
+			}
+
 			server = instance.Settings.BackupConfiguration.StartTime
 			local = attributes["settings.0.backup_configuration.0.start_time"]
 			if server != local && len(server) > 0 && len(local) > 0 {
@@ -549,10 +705,12 @@ func testAccCheckGoogleSqlDatabaseInstanceEquals(n string,
 			return fmt.Errorf("Error settings.crash_safe_replication mismatch, (%s, %s)", server, local)
 		}
 
-		server = strconv.FormatBool(instance.Settings.StorageAutoResize)
-		local = attributes["settings.0.disk_autoresize"]
-		if server != local && len(server) > 0 && len(local) > 0 {
-			return fmt.Errorf("Error settings.disk_autoresize mismatch, (%s, %s)", server, local)
+		if instance.Settings.StorageAutoResize != nil {
+			server = strconv.FormatBool(*instance.Settings.StorageAutoResize)
+			local = attributes["settings.0.disk_autoresize"]
+			if server != local && len(server) > 0 && len(local) > 0 {
+				return fmt.Errorf("Error settings.disk_autoresize mismatch, (%s, %s)", server, local)
+			}
 		}
 
 		server = strconv.FormatInt(instance.Settings.DataDiskSizeGb, 10)
@@ -579,6 +737,12 @@ func testAccCheckGoogleSqlDatabaseInstanceEquals(n string,
 			if server != local && len(server) > 0 && len(local) > 0 {
 				return fmt.Errorf("Error settings.ip_configuration.require_ssl mismatch, (%s, %s)", server, local)
 			}
+
+			server = instance.Settings.IpConfiguration.PrivateNetwork
+			local = attributes["settings.0.ip_configuration.0.private_network"]
+			if server != local && len(server) > 0 && len(local) > 0 {
+				return fmt.Errorf("Error settings.ip_configuration.private_network mismatch, (%s, %s)", server, local)
+			}
 		}
 
 		if instance.Settings.LocationPreference != nil {
@@ -661,6 +825,28 @@ func testAccCheckGoogleSqlDatabaseInstanceExists(n string,
 	}
 }
 
+func testAccCheckGoogleSqlDatabaseInstancePrivateNetwork(n, network string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		ns, ok := s.RootModule().Resources[network]
+		if !ok {
+			return fmt.Errorf("Not found: %s", network)
+		}
+
+		privateNetwork := rs.Primary.Attributes["settings.0.ip_configuration.0.private_network"]
+		selfLink := ns.Primary.Attributes["self_link"]
+		if !compareSelfLinkRelativePaths("", privateNetwork, selfLink, nil) {
+			return fmt.Errorf("Error settings.ip_configuration.private_network mismatch, (%s, %s)", privateNetwork, selfLink)
+		}
+
+		return nil
+	}
+}
+
 func testAccGoogleSqlDatabaseInstanceDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		config := testAccProvider.Meta().(*Config)
@@ -899,6 +1085,60 @@ resource "google_sql_database_instance" "instance" {
 }
 `
 
+var testGoogleSqlDatabaseInstance_postgresHighAvailability = `
+resource "google_sql_database_instance" "instance" {
+	name = "tf-lw-%d"
+	region = "us-central1"
+	database_version = "POSTGRES_9_6"
+
+	settings {
+		tier = "db-custom-1-3840"
+		availability_type = "%s"
+
+		backup_configuration {
+			enabled = true
+			start_time = "00:00"
+		}
+	}
+}
+`
+
+var testGoogleSqlDatabaseInstance_mysqlPointInTimeRecovery = `
+resource "google_sql_database_instance" "instance" {
+	name = "tf-lw-%d"
+	region = "us-central1"
+	database_version = "MYSQL_5_7"
+
+	settings {
+		tier = "db-n1-standard-1"
+
+		backup_configuration {
+			enabled = true
+			binary_log_enabled = true
+			start_time = "00:00"
+		}
+	}
+}
+`
+
+var testGoogleSqlDatabaseInstance_postgresPointInTimeRecovery = `
+resource "google_sql_database_instance" "instance" {
+	name = "tf-lw-%d"
+	region = "us-central1"
+	database_version = "POSTGRES_9_6"
+
+	settings {
+		tier = "db-custom-1-3840"
+
+		backup_configuration {
+			enabled = true
+			point_in_time_recovery_enabled = %t
+			start_time = "00:00"
+		}
+	}
+}
+`
+
 var testGoogleSqlDatabaseInstance_maintenance = `
 resource "google_sql_database_instance" "instance" {
 	name = "tf-lw-%d"
@@ -951,6 +1191,26 @@ resource "google_sql_database_instance" "instance" {
 }
 `
 
+var testGoogleSqlDatabaseInstance_privateNetwork = `
+data "google_compute_network" "network" {
+	name = "%s"
+}
+
+resource "google_sql_database_instance" "instance" {
+	name = "tf-lw-%d"
+	region = "us-central1"
+	database_version = "MYSQL_5_7"
+	settings {
+		tier = "db-f1-micro"
+
+		ip_configuration {
+			ipv4_enabled = "true"
+			private_network = "${data.google_compute_network.network.self_link}"
+		}
+	}
+}
+`
+
 var testGoogleSqlDatabaseInstance_multipleOperations = `
 resource "google_sql_database_instance" "instance" {
 	name = "tf-test-%s"
@@ -167,8 +167,8 @@ func resourceSqlUserUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		mutexKV.Lock(instanceMutexKey(project, instance))
 		defer mutexKV.Unlock(instanceMutexKey(project, instance))
-		op, err := config.clientSqlAdmin.Users.Update(project, instance, host, name,
-			user).Do()
+		op, err := config.clientSqlAdmin.Users.Update(project, instance,
+			user).Host(host).Name(name).Do()
 
 		if err != nil {
 			return fmt.Errorf("Error, failed to update"+
@@ -202,7 +202,7 @@ func resourceSqlUserDelete(d *schema.ResourceData, meta interface{}) error {
 
 	mutexKV.Lock(instanceMutexKey(project, instance))
 	defer mutexKV.Unlock(instanceMutexKey(project, instance))
-	op, err := config.clientSqlAdmin.Users.Delete(project, instance, host, name).Do()
+	op, err := config.clientSqlAdmin.Users.Delete(project, instance).Host(host).Name(name).Do()
 
 	if err != nil {
 		return fmt.Errorf("Error, failed to delete"+
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/storage/v1"
@@ -179,6 +180,69 @@ func resourceStorageBucket() *schema.Resource {
 				},
 			},
 
+			"logging": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_bucket": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"log_object_prefix": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"encryption": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_kms_key_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"retention_policy": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_locked": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"retention_period": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 3155760000),
+						},
+					},
+				},
+			},
+
+			"requester_pays": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"default_event_based_hold": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"cors": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -271,6 +335,28 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		sb.Cors = expandCors(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("logging"); ok {
+		sb.Logging = expandBucketLogging(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("encryption"); ok {
+		sb.Encryption = expandBucketEncryption(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_policy"); ok {
+		sb.RetentionPolicy = expandBucketRetentionPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("requester_pays"); ok {
+		sb.Billing = &storage.BucketBilling{
+			RequesterPays: v.(bool),
+		}
+	}
+
+	if v, ok := d.GetOk("default_event_based_hold"); ok {
+		sb.DefaultEventBasedHold = v.(bool)
+	}
+
 	var res *storage.Bucket
 
 	err = retry(func() error {
@@ -286,6 +372,13 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Created bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
 	d.SetId(res.Id)
+
+	if isBucketRetentionPolicyLocked(d.Get("retention_policy").([]interface{})) {
+		if err := lockRetentionPolicy(config.clientStorage.Buckets, bucket, res.Metageneration); err != nil {
+			return err
+		}
+	}
+
 	return resourceStorageBucketRead(d, meta)
 }
 
@@ -348,12 +441,74 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("logging") {
+		if v, ok := d.GetOk("logging"); ok {
+			sb.Logging = expandBucketLogging(v.([]interface{}))
+		} else {
+			sb.NullFields = append(sb.NullFields, "Logging")
+		}
+	}
+
+	if d.HasChange("encryption") {
+		if v, ok := d.GetOk("encryption"); ok {
+			sb.Encryption = expandBucketEncryption(v.([]interface{}))
+		} else {
+			sb.NullFields = append(sb.NullFields, "Encryption")
+		}
+	}
+
+	lockPolicy := false
+	if d.HasChange("retention_policy") {
+		o, n := d.GetChange("retention_policy")
+		oldPolicies, newPolicies := o.([]interface{}), n.([]interface{})
+
+		// A locked retention policy can't be removed, unlocked or have its
+		// retention period reduced, so fail early with a clear message rather
+		// than surfacing the API's error.
+		if isBucketRetentionPolicyLocked(oldPolicies) {
+			if len(newPolicies) == 0 || !isBucketRetentionPolicyLocked(newPolicies) {
+				return fmt.Errorf("Bucket %q has a locked retention policy, which cannot be removed or unlocked", d.Get("name").(string))
+			}
+
+			oldPeriod := oldPolicies[0].(map[string]interface{})["retention_period"].(int)
+			newPeriod := newPolicies[0].(map[string]interface{})["retention_period"].(int)
+			if newPeriod < oldPeriod {
+				return fmt.Errorf("Bucket %q has a locked retention policy, its retention_period can only be increased", d.Get("name").(string))
+			}
+		}
+
+		if len(newPolicies) > 0 {
+			sb.RetentionPolicy = expandBucketRetentionPolicy(newPolicies)
+			lockPolicy = isBucketRetentionPolicyLocked(newPolicies) && !isBucketRetentionPolicyLocked(oldPolicies)
+		} else {
+			sb.NullFields = append(sb.NullFields, "RetentionPolicy")
+		}
+	}
+
+	if d.HasChange("requester_pays") {
+		sb.Billing = &storage.BucketBilling{
+			RequesterPays:   d.Get("requester_pays").(bool),
+			ForceSendFields: []string{"RequesterPays"},
+		}
+	}
+
+	if d.HasChange("default_event_based_hold") {
+		sb.DefaultEventBasedHold = d.Get("default_event_based_hold").(bool)
+		sb.ForceSendFields = append(sb.ForceSendFields, "DefaultEventBasedHold")
+	}
+
 	res, err := config.clientStorage.Buckets.Patch(d.Get("name").(string), sb).Do()
 
 	if err != nil {
 		return err
 	}
 
+	if lockPolicy {
+		if err := lockRetentionPolicy(config.clientStorage.Buckets, res.Name, res.Metageneration); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Patched bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
 	// Assign the bucket ID as the resource ID
@@ -384,6 +539,12 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("cors", flattenCors(res.Cors))
 	d.Set("versioning", flattenBucketVersioning(res.Versioning))
 	d.Set("labels", res.Labels)
+	d.Set("logging", flattenBucketLogging(res.Logging))
+	d.Set("encryption", flattenBucketEncryption(res.Encryption))
+	d.Set("retention_policy", flattenBucketRetentionPolicy(res.RetentionPolicy))
+	d.Set("default_event_based_hold", res.DefaultEventBasedHold)
+	d.Set("requester_pays", res.Billing != nil && res.Billing.RequesterPays)
+
 	d.SetId(res.Id)
 	return nil
 }
@@ -507,6 +668,107 @@ func flattenBucketVersioning(bucketVersioning *storage.BucketVersioning) []map[s
 	return versionings
 }
 
+func expandBucketLogging(configured []interface{}) *storage.BucketLogging {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	loggingConfig := configured[0].(map[string]interface{})
+
+	return &storage.BucketLogging{
+		LogBucket:       loggingConfig["log_bucket"].(string),
+		LogObjectPrefix: loggingConfig["log_object_prefix"].(string),
+	}
+}
+
+func flattenBucketLogging(bucketLogging *storage.BucketLogging) []map[string]interface{} {
+	loggings := make([]map[string]interface{}, 0, 1)
+
+	if bucketLogging == nil {
+		return loggings
+	}
+
+	logging := map[string]interface{}{
+		"log_bucket":        bucketLogging.LogBucket,
+		"log_object_prefix": bucketLogging.LogObjectPrefix,
+	}
+	loggings = append(loggings, logging)
+	return loggings
+}
+
+func expandBucketEncryption(configured []interface{}) *storage.BucketEncryption {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	encryption := configured[0].(map[string]interface{})
+
+	return &storage.BucketEncryption{
+		DefaultKmsKeyName: encryption["default_kms_key_name"].(string),
+	}
+}
+
+func flattenBucketEncryption(enc *storage.BucketEncryption) []map[string]interface{} {
+	encryption := make([]map[string]interface{}, 0, 1)
+
+	if enc == nil {
+		return encryption
+	}
+
+	encryption = append(encryption, map[string]interface{}{
+		"default_kms_key_name": enc.DefaultKmsKeyName,
+	})
+	return encryption
+}
+
+func expandBucketRetentionPolicy(configured []interface{}) *storage.BucketRetentionPolicy {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	retentionPolicy := configured[0].(map[string]interface{})
+
+	// is_locked isn't sent here; locking is a separate call made once the
+	// policy is in place.
+	return &storage.BucketRetentionPolicy{
+		RetentionPeriod: int64(retentionPolicy["retention_period"].(int)),
+	}
+}
+
+func flattenBucketRetentionPolicy(bucketRetentionPolicy *storage.BucketRetentionPolicy) []map[string]interface{} {
+	bucketRetentionPolicies := make([]map[string]interface{}, 0, 1)
+
+	if bucketRetentionPolicy == nil {
+		return bucketRetentionPolicies
+	}
+
+	retentionPolicy := map[string]interface{}{
+		"is_locked":        bucketRetentionPolicy.IsLocked,
+		"retention_period": bucketRetentionPolicy.RetentionPeriod,
+	}
+
+	bucketRetentionPolicies = append(bucketRetentionPolicies, retentionPolicy)
+	return bucketRetentionPolicies
+}
+
+func isBucketRetentionPolicyLocked(configured []interface{}) bool {
+	if len(configured) == 0 || configured[0] == nil {
+		return false
+	}
+
+	retentionPolicy := configured[0].(map[string]interface{})
+	return retentionPolicy["is_locked"].(bool)
+}
+
+func lockRetentionPolicy(bucketsService *storage.BucketsService, bucketName string, metageneration int64) error {
+	lockPolicyCall := bucketsService.LockRetentionPolicy(bucketName, metageneration)
+	if _, err := lockPolicyCall.Do(); err != nil {
+		return fmt.Errorf("Error locking retention policy of bucket %q: %s", bucketName, err)
+	}
+
+	return nil
+}
+
 func resourceGCSBucketLifecycleCreateOrUpdate(d *schema.ResourceData, sb *storage.Bucket) error {
 	if v, ok := d.GetOk("lifecycle_rule"); ok {
 		lifecycle_rules := v.([]interface{})
@@ -2,10 +2,15 @@ package google
 
 import (
 	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -18,6 +23,9 @@ func resourceStorageBucketObject() *schema.Resource {
 		Create: resourceStorageBucketObjectCreate,
 		Read:   resourceStorageBucketObjectRead,
 		Delete: resourceStorageBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageBucketObjectImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
@@ -64,10 +72,11 @@ func resourceStorageBucketObject() *schema.Resource {
 			},
 
 			"content": &schema.Schema{
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"source"},
+				DiffSuppressFunc: compareImportedObjectContent,
 			},
 
 			"crc32c": &schema.Schema{
@@ -88,10 +97,11 @@ func resourceStorageBucketObject() *schema.Resource {
 			},
 
 			"source": &schema.Schema{
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"content"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"content"},
+				DiffSuppressFunc: compareImportedObjectSource,
 			},
 
 			"storage_class": &schema.Schema{
@@ -100,6 +110,41 @@ func resourceStorageBucketObject() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+
+			"customer_encryption": &schema.Schema{
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"kms_key_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_algorithm": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "AES256",
+						},
+						"encryption_key": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validateBase64EncodedAES256Key,
+						},
+					},
+				},
+			},
+
+			"kms_key_name": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"customer_encryption"},
+				DiffSuppressFunc: compareCryptoKeyVersions,
+			},
 		},
 	}
 }
@@ -154,10 +199,21 @@ func resourceStorageBucketObjectCreate(d *schema.ResourceData, meta interface{})
 		object.StorageClass = v.(string)
 	}
 
+	if v, ok := d.GetOk("kms_key_name"); ok {
+		object.KmsKeyName = v.(string)
+	}
+
 	insertCall := objectsService.Insert(bucket, object)
 	insertCall.Name(name)
 	insertCall.Media(media)
 
+	if v, ok := d.GetOk("customer_encryption"); ok {
+		customerEncryption := expandCustomerEncryption(v.([]interface{}))
+		if err := setEncryptionHeaders(customerEncryption, insertCall.Header()); err != nil {
+			return err
+		}
+	}
+
 	_, err := insertCall.Do()
 
 	if err != nil {
@@ -176,12 +232,21 @@ func resourceStorageBucketObjectRead(d *schema.ResourceData, meta interface{}) e
 	objectsService := storage.NewObjectsService(config.clientStorage)
 	getCall := objectsService.Get(bucket, name)
 
+	if v, ok := d.GetOk("customer_encryption"); ok {
+		customerEncryption := expandCustomerEncryption(v.([]interface{}))
+		if err := setEncryptionHeaders(customerEncryption, getCall.Header()); err != nil {
+			return err
+		}
+	}
+
 	res, err := getCall.Do()
 
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Storage Bucket Object %q", d.Get("name").(string)))
 	}
 
+	d.Set("bucket", res.Bucket)
+	d.Set("name", res.Name)
 	d.Set("md5hash", res.Md5Hash)
 	d.Set("crc32c", res.Crc32c)
 	d.Set("cache_control", res.CacheControl)
@@ -190,6 +255,7 @@ func resourceStorageBucketObjectRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("content_language", res.ContentLanguage)
 	d.Set("content_type", res.ContentType)
 	d.Set("storage_class", res.StorageClass)
+	d.Set("kms_key_name", res.KmsKeyName)
 
 	d.SetId(objectGetId(res))
 
@@ -221,3 +287,97 @@ func resourceStorageBucketObjectDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+func resourceStorageBucketObjectImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The object name may itself contain slashes, so only split on the first.
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid storage bucket object specifier %q, expected {bucket}/{name}", d.Id())
+	}
+
+	config := meta.(*Config)
+
+	// The key of an object encrypted with a customer-supplied key can't be read
+	// back, and adding customer_encryption after import would replace the object.
+	res, err := config.clientStorage.Objects.Get(parts[0], parts[1]).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading object %s: %s", d.Id(), err)
+	}
+	if res.CustomerEncryption != nil {
+		return nil, fmt.Errorf("Object %s is encrypted with a customer-supplied key and can't be imported", d.Id())
+	}
+
+	d.Set("bucket", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandCustomerEncryption(input []interface{}) map[string]string {
+	expanded := make(map[string]string)
+	if input == nil || len(input) == 0 || input[0] == nil {
+		return expanded
+	}
+	for _, v := range input {
+		original := v.(map[string]interface{})
+		expanded["encryption_key"] = original["encryption_key"].(string)
+		expanded["encryption_algorithm"] = original["encryption_algorithm"].(string)
+	}
+	return expanded
+}
+
+// setEncryptionHeaders adds the headers GCS requires on requests for objects
+// encrypted with a customer-supplied encryption key.
+func setEncryptionHeaders(customerEncryption map[string]string, headers http.Header) error {
+	decodedKey, err := base64.StdEncoding.DecodeString(customerEncryption["encryption_key"])
+	if err != nil {
+		return fmt.Errorf("Error decoding customer_encryption.encryption_key: %s", err)
+	}
+
+	keyHash := sha256.Sum256(decodedKey)
+	headers.Set("x-goog-encryption-algorithm", customerEncryption["encryption_algorithm"])
+	headers.Set("x-goog-encryption-key", customerEncryption["encryption_key"])
+	headers.Set("x-goog-encryption-key-sha256", base64.StdEncoding.EncodeToString(keyHash[:]))
+
+	return nil
+}
+
+// compareImportedObjectContent suppresses the diff on content for an imported
+// object, which has no content in its state, when the configured content has
+// the md5 hash GCS reports for the object.
+func compareImportedObjectContent(_, old, new string, d *schema.ResourceData) bool {
+	if old != "" || d.Id() == "" {
+		return old == new
+	}
+
+	h := md5.Sum([]byte(new))
+	return base64.StdEncoding.EncodeToString(h[:]) == d.Get("md5hash").(string)
+}
+
+// compareImportedObjectSource suppresses the diff on source for an imported
+// object, which has no source in its state, when the configured file has the
+// md5 hash GCS reports for the object.
+func compareImportedObjectSource(_, old, new string, d *schema.ResourceData) bool {
+	if old != "" || d.Id() == "" {
+		return old == new
+	}
+
+	md5hash, err := fileMd5Hash(new)
+	if err != nil {
+		return false
+	}
+
+	return md5hash == d.Get("md5hash").(string)
+}
+
+// compareCryptoKeyVersions suppresses diffs between a crypto key and the
+// specific version of it that GCS reports having used.
+func compareCryptoKeyVersions(_, old, new string, _ *schema.ResourceData) bool {
+	// The API returns a kms_key_name of the form
+	// projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/v
+	if new == "" || !strings.HasPrefix(old, new+"/cryptoKeyVersions/") {
+		return old == new
+	}
+
+	return true
+}
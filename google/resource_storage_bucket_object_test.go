@@ -5,9 +5,12 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
 	"google.golang.org/api/storage/v1"
@@ -186,13 +189,189 @@ func TestAccGoogleStorageObject_storageClass(t *testing.T) {
 	})
 }
 
+func TestAccGoogleStorageObject_customerEncryption(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+	data := []byte(content)
+	h := md5.New()
+	h.Write(data)
+	data_md5 := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	ioutil.WriteFile(tf.Name(), data, 0644)
+
+	customerEncryptionKey := "qI6+xvCZE9jUm94nJWIulFc8rthN56teYh3IVrEs0cQ="
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if err != nil {
+				panic(err)
+			}
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageBucketsObject_customerEncryption(bucketName, customerEncryptionKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleStorageObjectWithEncryption(bucketName, objectName, data_md5, customerEncryptionKey),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket_object.object", "customer_encryption.0.encryption_key", customerEncryptionKey),
+				),
+			},
+		},
+	})
+}
+
+func TestCompareCryptoKeyVersions(t *testing.T) {
+	t.Parallel()
+
+	key := "projects/p/locations/global/keyRings/r/cryptoKeys/k"
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"same key": {
+			Old:                key,
+			New:                key,
+			ExpectDiffSuppress: true,
+		},
+		"key version": {
+			Old:                key + "/cryptoKeyVersions/1",
+			New:                key,
+			ExpectDiffSuppress: true,
+		},
+		"different key": {
+			Old:                key + "/cryptoKeyVersions/1",
+			New:                "projects/p/locations/global/keyRings/r/cryptoKeys/other",
+			ExpectDiffSuppress: false,
+		},
+		"key removed": {
+			Old:                key + "/cryptoKeyVersions/1",
+			New:                "",
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if compareCryptoKeyVersions("kms_key_name", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, %q => %q expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestStorageObjectImportDiffSuppress(t *testing.T) {
+	h := md5.Sum([]byte(content))
+	md5hash := base64.StdEncoding.EncodeToString(h[:])
+
+	source, err := ioutil.TempFile("", "tf-test-object-source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(source.Name())
+	if _, err := source.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	source.Close()
+
+	imported := resourceStorageBucketObject().Data(&terraform.InstanceState{
+		ID:         "bucket-object",
+		Attributes: map[string]string{"md5hash": md5hash},
+	})
+	created := resourceStorageBucketObject().Data(nil)
+
+	cases := map[string]struct {
+		Suppress           schema.SchemaDiffSuppressFunc
+		Old, New           string
+		Data               *schema.ResourceData
+		ExpectDiffSuppress bool
+	}{
+		"imported with same content": {
+			Suppress:           compareImportedObjectContent,
+			New:                content,
+			Data:               imported,
+			ExpectDiffSuppress: true,
+		},
+		"imported with other content": {
+			Suppress:           compareImportedObjectContent,
+			New:                "other content",
+			Data:               imported,
+			ExpectDiffSuppress: false,
+		},
+		"changed content": {
+			Suppress:           compareImportedObjectContent,
+			Old:                "other content",
+			New:                content,
+			Data:               imported,
+			ExpectDiffSuppress: false,
+		},
+		"new object": {
+			Suppress:           compareImportedObjectContent,
+			New:                content,
+			Data:               created,
+			ExpectDiffSuppress: false,
+		},
+		"imported with same source": {
+			Suppress:           compareImportedObjectSource,
+			New:                source.Name(),
+			Data:               imported,
+			ExpectDiffSuppress: true,
+		},
+		"imported with missing source": {
+			Suppress:           compareImportedObjectSource,
+			New:                source.Name() + "-missing",
+			Data:               imported,
+			ExpectDiffSuppress: false,
+		},
+		"changed source": {
+			Suppress:           compareImportedObjectSource,
+			Old:                "other-file",
+			New:                source.Name(),
+			Data:               imported,
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if tc.Suppress("content", tc.Old, tc.New, tc.Data) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, %q => %q expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestSetEncryptionHeaders(t *testing.T) {
+	headers := make(http.Header)
+	err := setEncryptionHeaders(map[string]string{
+		"encryption_algorithm": "AES256",
+		"encryption_key":       "not base64!",
+	}, headers)
+	if err == nil {
+		t.Errorf("Expected an error for a key that isn't base64 encoded")
+	}
+	if len(headers) != 0 {
+		t.Errorf("Expected no headers for an invalid key, got %v", headers)
+	}
+}
+
 func testAccCheckGoogleStorageObject(bucket, object, md5 string) resource.TestCheckFunc {
+	return testAccCheckGoogleStorageObjectWithEncryption(bucket, object, md5, "")
+}
+
+func testAccCheckGoogleStorageObjectWithEncryption(bucket, object, md5, customerEncryptionKey string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
 
 		objectsService := storage.NewObjectsService(config.clientStorage)
 
 		getCall := objectsService.Get(bucket, object)
+		if customerEncryptionKey != "" {
+			err := setEncryptionHeaders(map[string]string{
+				"encryption_algorithm": "AES256",
+				"encryption_key":       customerEncryptionKey,
+			}, getCall.Header())
+			if err != nil {
+				return err
+			}
+		}
 		res, err := getCall.Do()
 
 		if err != nil {
@@ -307,3 +486,20 @@ resource "google_storage_bucket_object" "object" {
 }
 `, bucketName, objectName, content, storageClass)
 }
+
+func testGoogleStorageBucketsObject_customerEncryption(bucketName, customerEncryptionKey string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+}
+
+resource "google_storage_bucket_object" "object" {
+	name = "%s"
+	bucket = "${google_storage_bucket.bucket.name}"
+	content = "%s"
+	customer_encryption {
+		encryption_key = "%s"
+	}
+}
+`, bucketName, objectName, content, customerEncryptionKey)
+}
@@ -0,0 +1,346 @@
+package google
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+func resourceStorageBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageBucketObjectsCreate,
+		Read:   resourceStorageBucketObjectsRead,
+		Update: resourceStorageBucketObjectsUpdate,
+		Delete: resourceStorageBucketObjectsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// The state holds a hash of the files under source_dir rather than
+			// the path, so that changes to the local files show up as a diff
+			// when planning. The path itself is only read during apply.
+			"source_dir": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: hashStorageBucketObjectsSourceDir,
+			},
+
+			"prefix": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				StateFunc:    normalizeStorageBucketObjectsPrefix,
+				ValidateFunc: validateStorageBucketObjectsPrefix,
+			},
+
+			"objects": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// localObject is a file under source_dir that should exist in the bucket.
+type localObject struct {
+	path    string
+	md5hash string
+}
+
+func resourceStorageBucketObjectsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+	prefix := normalizeStorageBucketObjectsPrefix(d.Get("prefix"))
+
+	if err := syncStorageBucketObjects(config, bucket, prefix, d.Get("source_dir").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, prefix))
+
+	return setStorageBucketObjects(d, config, bucket, prefix)
+}
+
+func resourceStorageBucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+	prefix := normalizeStorageBucketObjectsPrefix(d.Get("prefix"))
+
+	remote, err := listStorageBucketObjects(config, bucket, prefix)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Storage Bucket %q", bucket))
+	}
+
+	// If the objects under the prefix changed since the last sync, clear the
+	// hash of source_dir so that the next plan shows an update that re-syncs.
+	// Only the bucket is compared here, the local files are compared when
+	// planning through the hash.
+	if !reflect.DeepEqual(convertStringMap(d.Get("objects").(map[string]interface{})), remote) {
+		log.Printf("[DEBUG] Objects under gs://%s/%s changed since they were last synced", bucket, prefix)
+		d.Set("source_dir", "")
+	}
+
+	d.Set("objects", remote)
+
+	return nil
+}
+
+func resourceStorageBucketObjectsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+	prefix := normalizeStorageBucketObjectsPrefix(d.Get("prefix"))
+
+	if err := syncStorageBucketObjects(config, bucket, prefix, d.Get("source_dir").(string)); err != nil {
+		return err
+	}
+
+	return setStorageBucketObjects(d, config, bucket, prefix)
+}
+
+func resourceStorageBucketObjectsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+	prefix := normalizeStorageBucketObjectsPrefix(d.Get("prefix"))
+
+	remote, err := listStorageBucketObjects(config, bucket, prefix)
+	if err != nil {
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+			log.Printf("[WARN] Bucket %q is gone, nothing to delete", bucket)
+			return nil
+		}
+		return err
+	}
+
+	for name := range remote {
+		if err := deleteStorageBucketObject(config, bucket, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setStorageBucketObjects records the objects found under prefix right after
+// a sync, which later reads compare against.
+func setStorageBucketObjects(d *schema.ResourceData, config *Config, bucket, prefix string) error {
+	remote, err := listStorageBucketObjects(config, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects in bucket %s: %s", bucket, err)
+	}
+
+	d.Set("objects", remote)
+
+	return nil
+}
+
+// normalizeStorageBucketObjectsPrefix makes the prefix end in a "/", so that it
+// only matches the objects of a directory: "data" must not match "database/x"
+// or "data-old.csv".
+func normalizeStorageBucketObjectsPrefix(v interface{}) string {
+	prefix := v.(string)
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return prefix
+	}
+
+	return prefix + "/"
+}
+
+func validateStorageBucketObjectsPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.Trim(value, "/") == "" {
+		errors = append(errors, fmt.Errorf("%q must name a directory in the bucket, got %q", k, value))
+	}
+	if strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must not start with a \"/\", got %q", k, value))
+	}
+
+	return
+}
+
+// hashStorageBucketObjectsSourceDir returns a hash of the relative paths and
+// contents of the files under the source directory. An unreadable directory
+// hashes to "", and the error is reported when syncing.
+func hashStorageBucketObjectsSourceDir(v interface{}) string {
+	sourceDir := v.(string)
+	local, err := listLocalObjects(sourceDir, "")
+	if err != nil {
+		log.Printf("[WARN] Unable to read source_dir %q: %s", sourceDir, err)
+		return ""
+	}
+
+	names := make([]string, 0, len(local))
+	for name := range local {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s %s\n", name, local[name].md5hash)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// syncStorageBucketObjects makes the objects under prefix in bucket match the
+// contents of sourceDir, uploading only the files whose md5 hash differs and
+// deleting objects with no matching file.
+func syncStorageBucketObjects(config *Config, bucket, prefix, sourceDir string) error {
+	local, err := listLocalObjects(sourceDir, prefix)
+	if err != nil {
+		return fmt.Errorf("Error reading source_dir %q: %s", sourceDir, err)
+	}
+
+	remote, err := listStorageBucketObjects(config, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects in bucket %s: %s", bucket, err)
+	}
+
+	objectsService := storage.NewObjectsService(config.clientStorage)
+	for name, obj := range local {
+		if remote[name] == obj.md5hash {
+			log.Printf("[DEBUG] Object %s is up to date", name)
+			continue
+		}
+
+		media, err := os.Open(obj.path)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Uploading %s to gs://%s/%s", obj.path, bucket, name)
+		insertCall := objectsService.Insert(bucket, &storage.Object{Bucket: bucket})
+		insertCall.Name(name)
+		insertCall.Media(media)
+
+		_, err = insertCall.Do()
+		media.Close()
+		if err != nil {
+			return fmt.Errorf("Error uploading object %s: %s", name, err)
+		}
+	}
+
+	for name := range remote {
+		if _, ok := local[name]; ok {
+			continue
+		}
+
+		if err := deleteStorageBucketObject(config, bucket, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listStorageBucketObjects returns the md5 hashes of the objects under prefix
+// in bucket, keyed by object name.
+func listStorageBucketObjects(config *Config, bucket, prefix string) (map[string]string, error) {
+	objects := make(map[string]string)
+
+	token := ""
+	for paginate := true; paginate; {
+		res, err := config.clientStorage.Objects.List(bucket).Prefix(prefix).PageToken(token).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range res.Items {
+			objects[object.Name] = object.Md5Hash
+		}
+
+		token = res.NextPageToken
+		paginate = token != ""
+	}
+
+	return objects, nil
+}
+
+// listLocalObjects walks sourceDir and returns the files found, keyed by the
+// object name they are uploaded as.
+func listLocalObjects(sourceDir, prefix string) (map[string]localObject, error) {
+	objects := make(map[string]localObject)
+
+	err := filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+
+		md5hash, err := fileMd5Hash(path)
+		if err != nil {
+			return err
+		}
+
+		objects[prefix+filepath.ToSlash(rel)] = localObject{
+			path:    path,
+			md5hash: md5hash,
+		}
+
+		return nil
+	})
+
+	return objects, err
+}
+
+// fileMd5Hash returns the base64 encoded md5 hash of a file, in the same
+// format GCS reports for objects.
+func fileMd5Hash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func deleteStorageBucketObject(config *Config, bucket, name string) error {
+	log.Printf("[DEBUG] Deleting gs://%s/%s", bucket, name)
+	err := config.clientStorage.Objects.Delete(bucket, name).Do()
+	if err != nil {
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting object %s: %s", name, err)
+	}
+
+	return nil
+}
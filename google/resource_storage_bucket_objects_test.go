@@ -0,0 +1,220 @@
+package google
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGoogleStorageObjects_sync(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+	sourceDir, err := ioutil.TempDir("", "tf-test-bucket-objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(sourceDir)
+
+	writeFile := func(name, data string) {
+		path := filepath.Join(sourceDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeFile("index.html", "<h1>hello</h1>")
+	writeFile("css/site.css", "h1 { color: red; }")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleStorageObjectsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageBucketObjects(bucketName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket_objects.site", "objects.%", "2"),
+					testAccCheckGoogleStorageObjectsInSync("google_storage_bucket_objects.site", sourceDir),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() {
+					writeFile("index.html", "<h1>hello again</h1>")
+					writeFile("js/site.js", "console.log('hi')")
+					os.Remove(filepath.Join(sourceDir, "css", "site.css"))
+				},
+				Config: testGoogleStorageBucketObjects(bucketName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket_objects.site", "objects.%", "2"),
+					resource.TestCheckNoResourceAttr(
+						"google_storage_bucket_objects.site", "objects.site/css/site.css"),
+					testAccCheckGoogleStorageObjectsInSync("google_storage_bucket_objects.site", sourceDir),
+				),
+			},
+			resource.TestStep{
+				// Objects removed from the bucket outside of Terraform are uploaded again.
+				PreConfig: func() {
+					config := testAccProvider.Meta().(*Config)
+					if err := deleteStorageBucketObject(config, bucketName, "site/index.html"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testGoogleStorageBucketObjects(bucketName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket_objects.site", "objects.%", "2"),
+					testAccCheckGoogleStorageObjectsInSync("google_storage_bucket_objects.site", sourceDir),
+				),
+			},
+		},
+	})
+}
+
+func TestStorageBucketObjectsPrefix(t *testing.T) {
+	cases := map[string]struct {
+		Prefix     string
+		Normalized string
+		ExpectErr  bool
+	}{
+		"directory":         {Prefix: "site/", Normalized: "site/"},
+		"no trailing slash": {Prefix: "data", Normalized: "data/"},
+		"nested":            {Prefix: "a/b", Normalized: "a/b/"},
+		"empty":             {Prefix: "", ExpectErr: true},
+		"root":              {Prefix: "/", ExpectErr: true},
+		"leading slash":     {Prefix: "/data", ExpectErr: true},
+		"only slashes":      {Prefix: "//", ExpectErr: true},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateStorageBucketObjectsPrefix(tc.Prefix, "prefix")
+		if tc.ExpectErr != (len(errs) > 0) {
+			t.Errorf("bad: %s, expected error: %t, got %v", tn, tc.ExpectErr, errs)
+		}
+		if tc.ExpectErr {
+			continue
+		}
+
+		if actual := normalizeStorageBucketObjectsPrefix(tc.Prefix); actual != tc.Normalized {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Normalized, actual)
+		}
+	}
+}
+
+func TestHashStorageBucketObjectsSourceDir(t *testing.T) {
+	sourceDir, err := ioutil.TempDir("", "tf-test-bucket-objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(sourceDir)
+
+	if err := os.MkdirAll(filepath.Join(sourceDir, "css"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(sourceDir, "css", "site.css"), []byte("h1 {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	original := hashStorageBucketObjectsSourceDir(sourceDir)
+	if original == "" {
+		t.Fatalf("Expected a hash for %s", sourceDir)
+	}
+	if again := hashStorageBucketObjectsSourceDir(sourceDir); again != original {
+		t.Errorf("Expected the same hash for unchanged files, got %q and %q", original, again)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(sourceDir, "css", "site.css"), []byte("h2 {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	changed := hashStorageBucketObjectsSourceDir(sourceDir)
+	if changed == original {
+		t.Errorf("Expected the hash to change with the contents of a file")
+	}
+
+	if err := os.Rename(filepath.Join(sourceDir, "css"), filepath.Join(sourceDir, "style")); err != nil {
+		t.Fatal(err)
+	}
+	if renamed := hashStorageBucketObjectsSourceDir(sourceDir); renamed == changed {
+		t.Errorf("Expected the hash to change with the path of a file")
+	}
+
+	if missing := hashStorageBucketObjectsSourceDir(filepath.Join(sourceDir, "missing")); missing != "" {
+		t.Errorf("Expected an empty hash for a missing directory, got %q", missing)
+	}
+}
+
+func testAccCheckGoogleStorageObjectsInSync(n, sourceDir string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		bucket := rs.Primary.Attributes["bucket"]
+		prefix := rs.Primary.Attributes["prefix"]
+
+		local, err := listLocalObjects(sourceDir, prefix)
+		if err != nil {
+			return err
+		}
+
+		remote, err := listStorageBucketObjects(config, bucket, prefix)
+		if err != nil {
+			return err
+		}
+
+		if len(local) != len(remote) {
+			return fmt.Errorf("Expected %d objects under gs://%s/%s, found %d", len(local), bucket, prefix, len(remote))
+		}
+
+		for name, obj := range local {
+			if remote[name] != obj.md5hash {
+				return fmt.Errorf("Object %s is out of sync: expected md5 %q, got %q", name, obj.md5hash, remote[name])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccGoogleStorageObjectsDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_storage_bucket_objects" {
+			continue
+		}
+
+		remote, err := listStorageBucketObjects(config, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["prefix"])
+		if err == nil && len(remote) > 0 {
+			return fmt.Errorf("Objects still exist under %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testGoogleStorageBucketObjects(bucketName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+}
+
+resource "google_storage_bucket_objects" "site" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	prefix = "site/"
+	source_dir = "%s"
+}
+`, bucketName, sourceDir)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -14,6 +15,62 @@ import (
 	storage "google.golang.org/api/storage/v1"
 )
 
+func init() {
+	resource.AddTestSweepers("gcp_storage_bucket", &resource.Sweeper{
+		Name: "gcp_storage_bucket",
+		Dependencies: []string{
+			"gcp_compute_backend_bucket",
+			"gcp_logging_project_sink",
+		},
+		F: testSweepStorageBuckets,
+	})
+}
+
+func testSweepStorageBuckets(region string) error {
+	config, err := sharedLoadedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	token := ""
+	for paginate := true; paginate; {
+		found, err := config.clientStorage.Buckets.List(config.Project).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("error listing storage buckets: %s", err)
+		}
+
+		for _, bucket := range found.Items {
+			name := bucket.Name
+			sweepResource("storage bucket", name, func() error {
+				// Buckets need to be emptied before they can be deleted.
+				objectsToken := ""
+				for paginateObjects := true; paginateObjects; {
+					objects, err := config.clientStorage.Objects.List(name).Versions(true).PageToken(objectsToken).Do()
+					if err != nil {
+						return err
+					}
+
+					for _, object := range objects.Items {
+						if err := config.clientStorage.Objects.Delete(name, object.Name).Generation(object.Generation).Do(); err != nil {
+							return err
+						}
+					}
+
+					objectsToken = objects.NextPageToken
+					paginateObjects = objectsToken != ""
+				}
+
+				return config.clientStorage.Buckets.Delete(name).Do()
+			})
+		}
+
+		token = found.NextPageToken
+		paginate = token != ""
+	}
+
+	return nil
+}
+
 func TestAccStorageBucket_basic(t *testing.T) {
 	t.Parallel()
 
@@ -357,6 +414,134 @@ func TestAccStorageBucket_versioning(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_logging(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccStorageBucket_logging(bucketName, "log-bucket"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "logging.#", "1"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "logging.0.log_bucket", "log-bucket"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "logging.0.log_object_prefix", bucketName),
+				),
+			},
+			resource.TestStep{
+				Config: testAccStorageBucket_loggingWithPrefix(bucketName, "another-log-bucket", "object-prefix"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "logging.#", "1"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "logging.0.log_bucket", "another-log-bucket"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "logging.0.log_object_prefix", "object-prefix"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccStorageBucket_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "logging.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_retentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccStorageBucket_retentionPolicy(bucketName, 10, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.retention_period", "10"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.is_locked", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccStorageBucket_retentionPolicy(bucketName, 20, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.retention_period", "20"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.is_locked", "true"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccStorageBucket_basic(bucketName),
+				ExpectError: regexp.MustCompile("locked retention policy"),
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_requesterPaysAndEventBasedHold(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccStorageBucket_requesterPaysAndEventBasedHold(bucketName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "requester_pays", "true"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "default_event_based_hold", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccStorageBucket_requesterPaysAndEventBasedHold(bucketName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "requester_pays", "false"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "default_event_based_hold", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_cors(t *testing.T) {
 	t.Parallel()
 
@@ -695,6 +880,51 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccStorageBucket_logging(bucketName string, logBucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	logging {
+		log_bucket = "%s"
+	}
+}
+`, bucketName, logBucketName)
+}
+
+func testAccStorageBucket_loggingWithPrefix(bucketName string, logBucketName string, prefix string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	logging {
+		log_bucket = "%s"
+		log_object_prefix = "%s"
+	}
+}
+`, bucketName, logBucketName, prefix)
+}
+
+func testAccStorageBucket_retentionPolicy(bucketName string, period int, locked bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	retention_policy {
+		retention_period = %d
+		is_locked = %t
+	}
+}
+`, bucketName, period, locked)
+}
+
+func testAccStorageBucket_requesterPaysAndEventBasedHold(bucketName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	requester_pays = %t
+	default_event_based_hold = %t
+}
+`, bucketName, enabled, enabled)
+}
+
 func testAccStorageBucket_lifecycleRules(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
@@ -0,0 +1,182 @@
+package google
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"google.golang.org/api/storage/v1"
+)
+
+func resourceStorageDefaultObjectAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageDefaultObjectAclCreate,
+		Read:   resourceStorageDefaultObjectAclRead,
+		Update: resourceStorageDefaultObjectAclUpdate,
+		Delete: resourceStorageDefaultObjectAclDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"role_entity": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceStorageDefaultObjectAclCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+
+	// The resource is authoritative, so any entries already present on the
+	// bucket that aren't in the config are removed.
+	res, err := config.clientStorage.DefaultObjectAccessControls.List(bucket).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading default object ACL for bucket %s: %v", bucket, err)
+	}
+
+	existing := make(map[string]string)
+	for _, v := range res.Items {
+		existing[v.Entity] = v.Role
+	}
+
+	if err := resourceStorageDefaultObjectAclApply(config, bucket, existing, d.Get("role_entity").([]interface{})); err != nil {
+		return err
+	}
+
+	d.SetId(bucket)
+
+	return resourceStorageDefaultObjectAclRead(d, meta)
+}
+
+func resourceStorageDefaultObjectAclRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+
+	res, err := config.clientStorage.DefaultObjectAccessControls.List(bucket).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Storage Default Object ACL for Bucket %q", bucket))
+	}
+
+	role_entity := make([]interface{}, 0)
+	for _, v := range res.Items {
+		log.Printf("[DEBUG]: saving re %s-%s", v.Role, v.Entity)
+		role_entity = append(role_entity, fmt.Sprintf("%s:%s", v.Role, v.Entity))
+	}
+
+	d.Set("role_entity", role_entity)
+
+	return nil
+}
+
+func resourceStorageDefaultObjectAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+
+	if d.HasChange("role_entity") {
+		o, n := d.GetChange("role_entity")
+		old_re, new_re := o.([]interface{}), n.([]interface{})
+
+		old_re_map := make(map[string]string)
+		for _, v := range old_re {
+			res, err := getRoleEntityPair(v.(string))
+
+			if err != nil {
+				return fmt.Errorf(
+					"Old state has malformed Role/Entity pair: %v", err)
+			}
+
+			old_re_map[res.Entity] = res.Role
+		}
+
+		if err := resourceStorageDefaultObjectAclApply(config, bucket, old_re_map, new_re); err != nil {
+			return err
+		}
+
+		return resourceStorageDefaultObjectAclRead(d, meta)
+	}
+
+	return nil
+}
+
+func resourceStorageDefaultObjectAclDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+
+	re_local := d.Get("role_entity").([]interface{})
+	for _, v := range re_local {
+		res, err := getRoleEntityPair(v.(string))
+		if err != nil {
+			return err
+		}
+
+		entity := res.Entity
+
+		log.Printf("[DEBUG]: removing entity %s", entity)
+
+		err = config.clientStorage.DefaultObjectAccessControls.Delete(bucket, entity).Do()
+
+		if err != nil {
+			return fmt.Errorf("Error deleting entity %s ACL: %s", entity, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceStorageDefaultObjectAclApply makes the default object ACL of the
+// bucket match new_re, given the entities currently set in old_re_map.
+func resourceStorageDefaultObjectAclApply(config *Config, bucket string, old_re_map map[string]string, new_re []interface{}) error {
+	for _, v := range new_re {
+		pair, err := getRoleEntityPair(v.(string))
+		if err != nil {
+			return err
+		}
+
+		objectAccessControl := &storage.ObjectAccessControl{
+			Role:   pair.Role,
+			Entity: pair.Entity,
+		}
+
+		// If the old state is missing this entity, it needs to
+		// be created. Otherwise it is updated
+		if _, ok := old_re_map[pair.Entity]; ok {
+			_, err = config.clientStorage.DefaultObjectAccessControls.Update(
+				bucket, pair.Entity, objectAccessControl).Do()
+		} else {
+			_, err = config.clientStorage.DefaultObjectAccessControls.Insert(
+				bucket, objectAccessControl).Do()
+		}
+
+		// Now we only store the keys that have to be removed
+		delete(old_re_map, pair.Entity)
+
+		if err != nil {
+			return fmt.Errorf("Error setting default object ACL for %s on bucket %s: %v", pair.Entity, bucket, err)
+		}
+	}
+
+	for entity := range old_re_map {
+		log.Printf("[DEBUG]: removing entity %s", entity)
+		err := config.clientStorage.DefaultObjectAccessControls.Delete(bucket, entity).Do()
+
+		if err != nil {
+			return fmt.Errorf("Error removing default object ACL for %s on bucket %s: %v", entity, bucket, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGoogleStorageDefaultObjectAcl_basic(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleStorageDefaultObjectAclDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageDefaultObjectsAclBasic(bucketName, roleEntityBasic1, roleEntityBasic2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic1),
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGoogleStorageDefaultObjectAcl_upgrade(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGoogleStorageDefaultObjectAclDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageDefaultObjectsAclBasic(bucketName, roleEntityBasic1, roleEntityBasic2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic1),
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic2),
+				),
+			},
+
+			resource.TestStep{
+				Config: testGoogleStorageDefaultObjectsAclBasic(bucketName, roleEntityBasic2, roleEntityBasic3_owner),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic2),
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic3_owner),
+					testAccCheckGoogleStorageDefaultObjectAclDelete(bucketName, roleEntityBasic1),
+				),
+			},
+
+			resource.TestStep{
+				Config: testGoogleStorageDefaultObjectsAclBasic(bucketName, roleEntityBasic2, roleEntityBasic3_reader),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic2),
+					testAccCheckGoogleStorageDefaultObjectAcl(bucketName, roleEntityBasic3_reader),
+					testAccCheckGoogleStorageDefaultObjectAclDelete(bucketName, roleEntityBasic3_owner),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleStorageDefaultObjectAcl(bucket, roleEntityS string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		roleEntity, _ := getRoleEntityPair(roleEntityS)
+		config := testAccProvider.Meta().(*Config)
+
+		res, err := config.clientStorage.DefaultObjectAccessControls.Get(bucket,
+			roleEntity.Entity).Do()
+
+		if err != nil {
+			return fmt.Errorf("Error retrieving contents of default object acl for bucket %s: %s", bucket, err)
+		}
+
+		if res.Role != roleEntity.Role {
+			return fmt.Errorf("Error, Role mismatch %s != %s", res.Role, roleEntity.Role)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGoogleStorageDefaultObjectAclDelete(bucket, roleEntityS string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		roleEntity, _ := getRoleEntityPair(roleEntityS)
+		config := testAccProvider.Meta().(*Config)
+
+		_, err := config.clientStorage.DefaultObjectAccessControls.Get(bucket, roleEntity.Entity).Do()
+
+		if err != nil {
+			return nil
+		}
+
+		return fmt.Errorf("Error, Entity still exists %s", roleEntity.Entity)
+	}
+}
+
+func testAccGoogleStorageDefaultObjectAclDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_storage_bucket" {
+			continue
+		}
+
+		bucket := rs.Primary.Attributes["name"]
+
+		_, err := config.clientStorage.DefaultObjectAccessControls.List(bucket).Do()
+
+		if err == nil {
+			return fmt.Errorf("Default Storage Object Acl for bucket %s still exists", bucket)
+		}
+	}
+	return nil
+}
+
+func testGoogleStorageDefaultObjectsAclBasic(bucketName, roleEntity1, roleEntity2 string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+}
+
+resource "google_storage_default_object_acl" "acl" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	role_entity = ["%s", "%s"]
+}
+`, bucketName, roleEntity1, roleEntity2)
+}
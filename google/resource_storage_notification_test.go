@@ -0,0 +1,220 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/storage/v1"
+)
+
+func TestAccStorageNotification_basic(t *testing.T) {
+	t.Parallel()
+
+	var notification storage.Notification
+	bucketName := acctest.RandomWithPrefix("tf-test-notification")
+	topicName := acctest.RandomWithPrefix("tf-pubsub")
+	topic := fmt.Sprintf("//pubsub.googleapis.com/projects/%s/topics/%s", getTestProjectFromEnv(), topicName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageNotificationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageNotificationBasic(bucketName, topicName, topic),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageNotificationExists(
+						"google_storage_notification.notification", &notification),
+					resource.TestCheckResourceAttr(
+						"google_storage_notification.notification", "bucket", bucketName),
+					resource.TestCheckResourceAttr(
+						"google_storage_notification.notification", "topic", topic),
+					resource.TestCheckResourceAttr(
+						"google_storage_notification.notification", "payload_format", "JSON_API_V1"),
+					resource.TestCheckResourceAttr(
+						"google_storage_notification.notification_with_prefix", "object_name_prefix", "foobar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageNotification_withEventsAndAttributes(t *testing.T) {
+	t.Parallel()
+
+	var notification storage.Notification
+	bucketName := acctest.RandomWithPrefix("tf-test-notification")
+	topicName := acctest.RandomWithPrefix("tf-pubsub")
+	topic := fmt.Sprintf("//pubsub.googleapis.com/projects/%s/topics/%s", getTestProjectFromEnv(), topicName)
+	eventType1 := "OBJECT_FINALIZE"
+	eventType2 := "OBJECT_ARCHIVE"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageNotificationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testGoogleStorageNotificationOptionalEventsAttributes(bucketName, topicName, topic, eventType1, eventType2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageNotificationExists(
+						"google_storage_notification.notification", &notification),
+					testAccCheckStorageNotificationCheckEventType(
+						&notification, []string{eventType1, eventType2}),
+					testAccCheckStorageNotificationCheckAttributes(
+						&notification, "new-attribute", "new-attribute-value"),
+					resource.TestCheckResourceAttr(
+						"google_storage_notification.notification", "event_types.#", "2"),
+					resource.TestCheckResourceAttr(
+						"google_storage_notification.notification", "custom_attributes.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageNotificationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_storage_notification" {
+			continue
+		}
+
+		bucket, notificationID, err := parseStorageNotificationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.clientStorage.Notifications.Get(bucket, notificationID).Do()
+		if err == nil {
+			return fmt.Errorf("Notification configuration still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckStorageNotificationExists(resource string, notification *storage.Notification) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		bucket, notificationID, err := parseStorageNotificationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := config.clientStorage.Notifications.Get(bucket, notificationID).Do()
+		if err != nil {
+			return err
+		}
+
+		if found.Id != notificationID {
+			return fmt.Errorf("Storage notification configuration not found")
+		}
+
+		*notification = *found
+
+		return nil
+	}
+}
+
+func testAccCheckStorageNotificationCheckEventType(notification *storage.Notification, eventTypes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !reflect.DeepEqual(notification.EventTypes, eventTypes) {
+			return fmt.Errorf("Target event types are incorrect. Expected %s, got %s", eventTypes, notification.EventTypes)
+		}
+		return nil
+	}
+}
+
+func testAccCheckStorageNotificationCheckAttributes(notification *storage.Notification, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		val, ok := notification.CustomAttributes[key]
+		if !ok {
+			return fmt.Errorf("Custom attribute with key %s not found", key)
+		}
+
+		if val != value {
+			return fmt.Errorf("Custom attribute value did not match for key %s: expected %s but found %s", key, value, val)
+		}
+		return nil
+	}
+}
+
+func testGoogleStorageNotificationBasic(bucketName, topicName, topic string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+}
+
+resource "google_pubsub_topic" "topic" {
+	name = "%s"
+}
+
+data "google_storage_project_service_account" "gcs_account" {}
+
+resource "google_project_iam_member" "publisher" {
+	role = "roles/pubsub.publisher"
+	member = "serviceAccount:${data.google_storage_project_service_account.gcs_account.email_address}"
+}
+
+resource "google_storage_notification" "notification" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	payload_format = "JSON_API_V1"
+	topic = "${google_pubsub_topic.topic.id}"
+	depends_on = ["google_project_iam_member.publisher"]
+}
+
+resource "google_storage_notification" "notification_with_prefix" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	payload_format = "JSON_API_V1"
+	topic = "%s"
+	object_name_prefix = "foobar"
+	depends_on = ["google_project_iam_member.publisher"]
+}
+`, bucketName, topicName, topic)
+}
+
+func testGoogleStorageNotificationOptionalEventsAttributes(bucketName, topicName, topic, eventType1, eventType2 string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+}
+
+resource "google_pubsub_topic" "topic" {
+	name = "%s"
+}
+
+data "google_storage_project_service_account" "gcs_account" {}
+
+resource "google_project_iam_member" "publisher" {
+	role = "roles/pubsub.publisher"
+	member = "serviceAccount:${data.google_storage_project_service_account.gcs_account.email_address}"
+}
+
+resource "google_storage_notification" "notification" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	payload_format = "JSON_API_V1"
+	topic = "%s"
+	event_types = ["%s","%s"]
+	custom_attributes {
+		new-attribute = "new-attribute-value"
+	}
+	depends_on = ["google_project_iam_member.publisher", "google_pubsub_topic.topic"]
+}
+`, bucketName, topicName, topic, eventType1, eventType2)
+}
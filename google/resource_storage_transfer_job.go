@@ -0,0 +1,717 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/storagetransfer/v1"
+)
+
+func resourceStorageTransferJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageTransferJobCreate,
+		Read:   resourceStorageTransferJobRead,
+		Update: resourceStorageTransferJobUpdate,
+		Delete: resourceStorageTransferJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageTransferJobStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+
+			"transfer_spec": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gcs_data_sink": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     gcsDataSchema(),
+						},
+						"gcs_data_source": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							Elem:          gcsDataSchema(),
+							ConflictsWith: []string{"transfer_spec.0.aws_s3_data_source", "transfer_spec.0.http_data_source"},
+						},
+						"aws_s3_data_source": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							Elem:          awsS3DataSchema(),
+							ConflictsWith: []string{"transfer_spec.0.gcs_data_source", "transfer_spec.0.http_data_source"},
+						},
+						"http_data_source": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							Elem:          httpDataSchema(),
+							ConflictsWith: []string{"transfer_spec.0.gcs_data_source", "transfer_spec.0.aws_s3_data_source"},
+						},
+						"object_conditions": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     objectConditionsSchema(),
+						},
+						"transfer_options": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     transferOptionsSchema(),
+						},
+					},
+				},
+			},
+
+			"schedule": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_start_date": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     dateObjectSchema(),
+						},
+						"schedule_end_date": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     dateObjectSchema(),
+						},
+						"start_time_of_day": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     timeObjectSchema(),
+						},
+					},
+				},
+			},
+
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ENABLED",
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED"}, false),
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modification_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"deletion_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func gcsDataSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func awsS3DataSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"aws_access_key": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"secret_access_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func httpDataSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"list_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func objectConditionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"max_time_elapsed_since_last_modification": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"min_time_elapsed_since_last_modification": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"include_prefixes": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1000,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude_prefixes": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1000,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func transferOptionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"overwrite_objects_already_existing_in_sink": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"delete_objects_unique_in_sink": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"transfer_spec.0.transfer_options.0.delete_objects_from_source_after_transfer"},
+			},
+			"delete_objects_from_source_after_transfer": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"transfer_spec.0.transfer_options.0.delete_objects_unique_in_sink"},
+			},
+		},
+	}
+}
+
+func dateObjectSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"year": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 9999),
+			},
+			"month": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 12),
+			},
+			"day": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 31),
+			},
+		},
+	}
+}
+
+func timeObjectSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hours": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"minutes": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 59),
+			},
+			"seconds": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 59),
+			},
+			"nanos": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 999999999),
+			},
+		},
+	}
+}
+
+func resourceStorageTransferJobCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	transferSpec, err := expandTransferSpecs(d.Get("transfer_spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	transferJob := &storagetransfer.TransferJob{
+		Description:  d.Get("description").(string),
+		ProjectId:    project,
+		Status:       d.Get("status").(string),
+		Schedule:     expandTransferSchedules(d.Get("schedule").([]interface{})),
+		TransferSpec: transferSpec,
+	}
+
+	log.Printf("[DEBUG] Creating transfer job %#v", transferJob)
+	res, err := config.clientStorageTransfer.TransferJobs.Create(transferJob).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating transfer job: %s", err)
+	}
+
+	d.Set("name", res.Name)
+	d.SetId(fmt.Sprintf("%s/%s", project, res.Name))
+
+	return resourceStorageTransferJobRead(d, meta)
+}
+
+func resourceStorageTransferJobRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	res, err := config.clientStorageTransfer.TransferJobs.Get(name).ProjectId(project).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Transfer Job %q", name))
+	}
+
+	// Deleted jobs are still returned for a while, with a DELETED status.
+	if res.Status == "DELETED" {
+		log.Printf("[WARN] Removing Transfer Job %q because it's been deleted", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", res.ProjectId)
+	d.Set("name", res.Name)
+	d.Set("description", res.Description)
+	d.Set("status", res.Status)
+	d.Set("creation_time", res.CreationTime)
+	d.Set("last_modification_time", res.LastModificationTime)
+	d.Set("deletion_time", res.DeletionTime)
+
+	if err := d.Set("schedule", flattenTransferSchedule(res.Schedule)); err != nil {
+		return fmt.Errorf("Error setting schedule: %s", err)
+	}
+
+	if err := d.Set("transfer_spec", flattenTransferSpec(res.TransferSpec, d)); err != nil {
+		return fmt.Errorf("Error setting transfer_spec: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageTransferJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	transferJob := &storagetransfer.TransferJob{}
+	fieldMask := []string{}
+
+	if d.HasChange("description") {
+		fieldMask = append(fieldMask, "description")
+		transferJob.Description = d.Get("description").(string)
+	}
+
+	if d.HasChange("status") {
+		fieldMask = append(fieldMask, "status")
+		transferJob.Status = d.Get("status").(string)
+	}
+
+	if d.HasChange("schedule") {
+		fieldMask = append(fieldMask, "schedule")
+		transferJob.Schedule = expandTransferSchedules(d.Get("schedule").([]interface{}))
+	}
+
+	if d.HasChange("transfer_spec") {
+		fieldMask = append(fieldMask, "transfer_spec")
+		transferJob.TransferSpec, err = expandTransferSpecs(d.Get("transfer_spec").([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(fieldMask) == 0 {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	updateRequest := &storagetransfer.UpdateTransferJobRequest{
+		ProjectId:                  project,
+		TransferJob:                transferJob,
+		UpdateTransferJobFieldMask: strings.Join(fieldMask, ","),
+	}
+
+	_, err = config.clientStorageTransfer.TransferJobs.Patch(name, updateRequest).Do()
+	if err != nil {
+		return fmt.Errorf("Error updating transfer job %q: %s", name, err)
+	}
+
+	return resourceStorageTransferJobRead(d, meta)
+}
+
+func resourceStorageTransferJobDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	// Transfer jobs can't be deleted directly, they're marked as DELETED and
+	// garbage collected by the service after 30 days.
+	name := d.Get("name").(string)
+	updateRequest := &storagetransfer.UpdateTransferJobRequest{
+		ProjectId: project,
+		TransferJob: &storagetransfer.TransferJob{
+			Status: "DELETED",
+		},
+		UpdateTransferJobFieldMask: "status",
+	}
+
+	log.Printf("[DEBUG] Deleting transfer job %q", name)
+	_, err = config.clientStorageTransfer.TransferJobs.Patch(name, updateRequest).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Transfer Job %q", name))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceStorageTransferJobStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || !strings.HasPrefix(parts[1], "transferJobs/") {
+		return nil, fmt.Errorf("Invalid transfer job id %q, expected format: $PROJECT/transferJobs/$JOBID", d.Id())
+	}
+
+	d.Set("project", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandGcsData(gcsDatas []interface{}) *storagetransfer.GcsData {
+	if len(gcsDatas) == 0 || gcsDatas[0] == nil {
+		return nil
+	}
+
+	gcsData := gcsDatas[0].(map[string]interface{})
+	return &storagetransfer.GcsData{
+		BucketName: gcsData["bucket_name"].(string),
+	}
+}
+
+func flattenGcsData(gcsData *storagetransfer.GcsData) []map[string]interface{} {
+	data := map[string]interface{}{
+		"bucket_name": gcsData.BucketName,
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandAwsS3Data(awsS3Datas []interface{}) *storagetransfer.AwsS3Data {
+	if len(awsS3Datas) == 0 || awsS3Datas[0] == nil {
+		return nil
+	}
+
+	awsS3Data := awsS3Datas[0].(map[string]interface{})
+	return &storagetransfer.AwsS3Data{
+		BucketName:   awsS3Data["bucket_name"].(string),
+		AwsAccessKey: expandAwsAccessKeys(awsS3Data["aws_access_key"].([]interface{})),
+	}
+}
+
+// flattenAwsS3Data takes the access key from the config, since the API never
+// returns it.
+func flattenAwsS3Data(awsS3Data *storagetransfer.AwsS3Data, d *schema.ResourceData) []map[string]interface{} {
+	data := map[string]interface{}{
+		"bucket_name":    awsS3Data.BucketName,
+		"aws_access_key": d.Get("transfer_spec.0.aws_s3_data_source.0.aws_access_key"),
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandAwsAccessKeys(awsAccessKeys []interface{}) *storagetransfer.AwsAccessKey {
+	if len(awsAccessKeys) == 0 || awsAccessKeys[0] == nil {
+		return nil
+	}
+
+	awsAccessKey := awsAccessKeys[0].(map[string]interface{})
+	return &storagetransfer.AwsAccessKey{
+		AccessKeyId:     awsAccessKey["access_key_id"].(string),
+		SecretAccessKey: awsAccessKey["secret_access_key"].(string),
+	}
+}
+
+func expandHttpData(httpDatas []interface{}) *storagetransfer.HttpData {
+	if len(httpDatas) == 0 || httpDatas[0] == nil {
+		return nil
+	}
+
+	httpData := httpDatas[0].(map[string]interface{})
+	return &storagetransfer.HttpData{
+		ListUrl: httpData["list_url"].(string),
+	}
+}
+
+func flattenHttpData(httpData *storagetransfer.HttpData) []map[string]interface{} {
+	data := map[string]interface{}{
+		"list_url": httpData.ListUrl,
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandObjectConditions(conditions []interface{}) *storagetransfer.ObjectConditions {
+	if len(conditions) == 0 || conditions[0] == nil {
+		return nil
+	}
+
+	condition := conditions[0].(map[string]interface{})
+	return &storagetransfer.ObjectConditions{
+		ExcludePrefixes:                     convertStringArr(condition["exclude_prefixes"].([]interface{})),
+		IncludePrefixes:                     convertStringArr(condition["include_prefixes"].([]interface{})),
+		MaxTimeElapsedSinceLastModification: condition["max_time_elapsed_since_last_modification"].(string),
+		MinTimeElapsedSinceLastModification: condition["min_time_elapsed_since_last_modification"].(string),
+	}
+}
+
+func flattenObjectCondition(condition *storagetransfer.ObjectConditions) []map[string]interface{} {
+	data := map[string]interface{}{
+		"exclude_prefixes":                         condition.ExcludePrefixes,
+		"include_prefixes":                         condition.IncludePrefixes,
+		"max_time_elapsed_since_last_modification": condition.MaxTimeElapsedSinceLastModification,
+		"min_time_elapsed_since_last_modification": condition.MinTimeElapsedSinceLastModification,
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandTransferOptions(options []interface{}) *storagetransfer.TransferOptions {
+	if len(options) == 0 || options[0] == nil {
+		return nil
+	}
+
+	option := options[0].(map[string]interface{})
+	return &storagetransfer.TransferOptions{
+		DeleteObjectsFromSourceAfterTransfer:  option["delete_objects_from_source_after_transfer"].(bool),
+		DeleteObjectsUniqueInSink:             option["delete_objects_unique_in_sink"].(bool),
+		OverwriteObjectsAlreadyExistingInSink: option["overwrite_objects_already_existing_in_sink"].(bool),
+	}
+}
+
+func flattenTransferOption(option *storagetransfer.TransferOptions) []map[string]interface{} {
+	data := map[string]interface{}{
+		"delete_objects_from_source_after_transfer":  option.DeleteObjectsFromSourceAfterTransfer,
+		"delete_objects_unique_in_sink":              option.DeleteObjectsUniqueInSink,
+		"overwrite_objects_already_existing_in_sink": option.OverwriteObjectsAlreadyExistingInSink,
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandTransferSpecs(transferSpecs []interface{}) (*storagetransfer.TransferSpec, error) {
+	if len(transferSpecs) == 0 || transferSpecs[0] == nil {
+		return nil, nil
+	}
+
+	transferSpec := transferSpecs[0].(map[string]interface{})
+
+	sources := 0
+	for _, key := range []string{"gcs_data_source", "aws_s3_data_source", "http_data_source"} {
+		if len(transferSpec[key].([]interface{})) > 0 {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, fmt.Errorf("exactly one of gcs_data_source, aws_s3_data_source or http_data_source must be set in transfer_spec")
+	}
+
+	return &storagetransfer.TransferSpec{
+		GcsDataSink:      expandGcsData(transferSpec["gcs_data_sink"].([]interface{})),
+		ObjectConditions: expandObjectConditions(transferSpec["object_conditions"].([]interface{})),
+		TransferOptions:  expandTransferOptions(transferSpec["transfer_options"].([]interface{})),
+		GcsDataSource:    expandGcsData(transferSpec["gcs_data_source"].([]interface{})),
+		AwsS3DataSource:  expandAwsS3Data(transferSpec["aws_s3_data_source"].([]interface{})),
+		HttpDataSource:   expandHttpData(transferSpec["http_data_source"].([]interface{})),
+	}, nil
+}
+
+func flattenTransferSpec(transferSpec *storagetransfer.TransferSpec, d *schema.ResourceData) []map[string]interface{} {
+	if transferSpec == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{}
+
+	if transferSpec.GcsDataSink != nil {
+		data["gcs_data_sink"] = flattenGcsData(transferSpec.GcsDataSink)
+	}
+	if transferSpec.ObjectConditions != nil {
+		data["object_conditions"] = flattenObjectCondition(transferSpec.ObjectConditions)
+	}
+	if transferSpec.TransferOptions != nil {
+		data["transfer_options"] = flattenTransferOption(transferSpec.TransferOptions)
+	}
+	if transferSpec.GcsDataSource != nil {
+		data["gcs_data_source"] = flattenGcsData(transferSpec.GcsDataSource)
+	} else if transferSpec.AwsS3DataSource != nil {
+		data["aws_s3_data_source"] = flattenAwsS3Data(transferSpec.AwsS3DataSource, d)
+	} else if transferSpec.HttpDataSource != nil {
+		data["http_data_source"] = flattenHttpData(transferSpec.HttpDataSource)
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandDates(dates []interface{}) *storagetransfer.Date {
+	if len(dates) == 0 || dates[0] == nil {
+		return nil
+	}
+
+	date := dates[0].(map[string]interface{})
+	return &storagetransfer.Date{
+		Day:   int64(date["day"].(int)),
+		Month: int64(date["month"].(int)),
+		Year:  int64(date["year"].(int)),
+	}
+}
+
+func flattenDate(date *storagetransfer.Date) []map[string]interface{} {
+	data := map[string]interface{}{
+		"year":  date.Year,
+		"month": date.Month,
+		"day":   date.Day,
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandTimeOfDays(times []interface{}) *storagetransfer.TimeOfDay {
+	if len(times) == 0 || times[0] == nil {
+		return nil
+	}
+
+	timeOfDay := times[0].(map[string]interface{})
+	return &storagetransfer.TimeOfDay{
+		Hours:   int64(timeOfDay["hours"].(int)),
+		Minutes: int64(timeOfDay["minutes"].(int)),
+		Seconds: int64(timeOfDay["seconds"].(int)),
+		Nanos:   int64(timeOfDay["nanos"].(int)),
+	}
+}
+
+func flattenTimeOfDay(timeOfDay *storagetransfer.TimeOfDay) []map[string]interface{} {
+	data := map[string]interface{}{
+		"hours":   timeOfDay.Hours,
+		"minutes": timeOfDay.Minutes,
+		"seconds": timeOfDay.Seconds,
+		"nanos":   timeOfDay.Nanos,
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandTransferSchedules(transferSchedules []interface{}) *storagetransfer.Schedule {
+	if len(transferSchedules) == 0 || transferSchedules[0] == nil {
+		return nil
+	}
+
+	schedule := transferSchedules[0].(map[string]interface{})
+	return &storagetransfer.Schedule{
+		ScheduleStartDate: expandDates(schedule["schedule_start_date"].([]interface{})),
+		ScheduleEndDate:   expandDates(schedule["schedule_end_date"].([]interface{})),
+		StartTimeOfDay:    expandTimeOfDays(schedule["start_time_of_day"].([]interface{})),
+	}
+}
+
+func flattenTransferSchedule(transferSchedule *storagetransfer.Schedule) []map[string]interface{} {
+	if transferSchedule == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{}
+
+	if transferSchedule.ScheduleStartDate != nil {
+		data["schedule_start_date"] = flattenDate(transferSchedule.ScheduleStartDate)
+	}
+	if transferSchedule.ScheduleEndDate != nil {
+		data["schedule_end_date"] = flattenDate(transferSchedule.ScheduleEndDate)
+	}
+	if transferSchedule.StartTimeOfDay != nil {
+		data["start_time_of_day"] = flattenTimeOfDay(transferSchedule.StartTimeOfDay)
+	}
+
+	return []map[string]interface{}{data}
+}
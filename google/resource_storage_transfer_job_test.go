@@ -0,0 +1,210 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccStorageTransferJob_basic(t *testing.T) {
+	t.Parallel()
+
+	testDataSourceBucketName := acctest.RandomWithPrefix("tf-test-transfer-source")
+	testDataSinkName := acctest.RandomWithPrefix("tf-test-transfer-sink")
+	testTransferJobDescription := acctest.RandString(10)
+	testUpdatedDataSourceBucketName := acctest.RandomWithPrefix("tf-test-transfer-source-updated")
+	testUpdatedDataSinkBucketName := acctest.RandomWithPrefix("tf-test-transfer-sink-updated")
+	testUpdatedTransferJobDescription := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageTransferJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageTransferJob_basic(getTestProjectFromEnv(), testDataSourceBucketName, testDataSinkName, testTransferJobDescription),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_transfer_job.transfer_job", "status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("google_storage_transfer_job.transfer_job", "name"),
+				),
+			},
+			{
+				Config: testAccStorageTransferJob_basic(getTestProjectFromEnv(), testUpdatedDataSourceBucketName, testDataSinkName, testTransferJobDescription),
+			},
+			{
+				Config: testAccStorageTransferJob_basic(getTestProjectFromEnv(), testUpdatedDataSourceBucketName, testUpdatedDataSinkBucketName, testTransferJobDescription),
+			},
+			{
+				Config: testAccStorageTransferJob_basic(getTestProjectFromEnv(), testUpdatedDataSourceBucketName, testUpdatedDataSinkBucketName, testUpdatedTransferJobDescription),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_transfer_job.transfer_job", "description", testUpdatedTransferJobDescription),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageTransferJob_disabled(t *testing.T) {
+	t.Parallel()
+
+	testDataSourceBucketName := acctest.RandomWithPrefix("tf-test-transfer-source")
+	testDataSinkName := acctest.RandomWithPrefix("tf-test-transfer-sink")
+	testTransferJobDescription := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageTransferJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageTransferJob_disabled(getTestProjectFromEnv(), testDataSourceBucketName, testDataSinkName, testTransferJobDescription),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_transfer_job.transfer_job", "status", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccStorageTransferJob_basic(getTestProjectFromEnv(), testDataSourceBucketName, testDataSinkName, testTransferJobDescription),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_transfer_job.transfer_job", "status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageTransferJobDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_storage_transfer_job" {
+			continue
+		}
+
+		rs_attr := rs.Primary.Attributes
+		name, ok := rs_attr["name"]
+		if !ok {
+			return fmt.Errorf("No name set")
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		res, err := config.clientStorageTransfer.TransferJobs.Get(name).ProjectId(project).Do()
+		if err != nil {
+			return fmt.Errorf("Transfer Job does not exist, should exist and be DELETED")
+		}
+		if res.Status != "DELETED" {
+			return fmt.Errorf("Transfer Job not set to DELETED")
+		}
+	}
+
+	return nil
+}
+
+func testAccStorageTransferJobConfigBuckets(project string, dataSourceBucketName string, dataSinkBucketName string) string {
+	return fmt.Sprintf(`
+data "google_storage_transfer_project_service_account" "default" {
+	project = "%s"
+}
+
+resource "google_storage_bucket" "data_source" {
+	name          = "%s"
+	project       = "%s"
+	force_destroy = true
+}
+
+resource "google_storage_bucket" "data_sink" {
+	name          = "%s"
+	project       = "%s"
+	force_destroy = true
+}
+
+resource "google_project_iam_member" "storage_transfer" {
+	project = "%s"
+	role    = "roles/storage.admin"
+	member  = "serviceAccount:${data.google_storage_transfer_project_service_account.default.email}"
+}
+`, project, dataSourceBucketName, project, dataSinkBucketName, project, project)
+}
+
+func testAccStorageTransferJob_basic(project string, dataSourceBucketName string, dataSinkBucketName string, transferJobDescription string) string {
+	return testAccStorageTransferJobConfigBuckets(project, dataSourceBucketName, dataSinkBucketName) + fmt.Sprintf(`
+resource "google_storage_transfer_job" "transfer_job" {
+	description = "%s"
+	project     = "%s"
+
+	transfer_spec {
+		gcs_data_source {
+			bucket_name = "${google_storage_bucket.data_source.name}"
+		}
+		gcs_data_sink {
+			bucket_name = "${google_storage_bucket.data_sink.name}"
+		}
+		object_conditions {
+			max_time_elapsed_since_last_modification = "600s"
+			exclude_prefixes = [
+				"requests.tar.gz"
+			]
+		}
+		transfer_options {
+			delete_objects_unique_in_sink = true
+		}
+	}
+
+	schedule {
+		schedule_start_date {
+			year  = 2018
+			month = 10
+			day   = 1
+		}
+		schedule_end_date {
+			year  = 2019
+			month = 10
+			day   = 1
+		}
+		start_time_of_day {
+			hours   = 23
+			minutes = 30
+			seconds = 0
+			nanos   = 0
+		}
+	}
+
+	depends_on = ["google_project_iam_member.storage_transfer"]
+}
+`, transferJobDescription, project)
+}
+
+func testAccStorageTransferJob_disabled(project string, dataSourceBucketName string, dataSinkBucketName string, transferJobDescription string) string {
+	return testAccStorageTransferJobConfigBuckets(project, dataSourceBucketName, dataSinkBucketName) + fmt.Sprintf(`
+resource "google_storage_transfer_job" "transfer_job" {
+	description = "%s"
+	project     = "%s"
+	status      = "DISABLED"
+
+	transfer_spec {
+		gcs_data_source {
+			bucket_name = "${google_storage_bucket.data_source.name}"
+		}
+		gcs_data_sink {
+			bucket_name = "${google_storage_bucket.data_sink.name}"
+		}
+	}
+
+	schedule {
+		schedule_start_date {
+			year  = 2018
+			month = 10
+			day   = 1
+		}
+	}
+
+	depends_on = ["google_project_iam_member.storage_transfer"]
+}
+`, transferJobDescription, project)
+}
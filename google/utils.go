@@ -5,7 +5,6 @@ package google
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -153,46 +152,6 @@ func getNetworkLink(d *schema.ResourceData, config *Config, field string) (strin
 	}
 }
 
-// Reads the "subnetwork" fields from the given resource data and if the value is:
-// - a resource URL, returns the string unchanged
-// - a subnetwork name, looks up the resource URL using the google client.
-//
-// If `subnetworkField` is a resource url, `subnetworkProjectField` cannot be set.
-// If `subnetworkField` is a subnetwork name, `subnetworkProjectField` will be used
-// 	as the project if set. If not, we fallback on the default project.
-func getSubnetworkLink(d *schema.ResourceData, config *Config, subnetworkField, subnetworkProjectField, zoneField string) (string, error) {
-	if v, ok := d.GetOk(subnetworkField); ok {
-		subnetwork := v.(string)
-		r := regexp.MustCompile(SubnetworkLinkRegex)
-		if r.MatchString(subnetwork) {
-			return subnetwork, nil
-		}
-
-		var project string
-		if subnetworkProject, ok := d.GetOk(subnetworkProjectField); ok {
-			project = subnetworkProject.(string)
-		} else {
-			var err error
-			project, err = getProject(d, config)
-			if err != nil {
-				return "", err
-			}
-		}
-
-		region := getRegionFromZone(d.Get(zoneField).(string))
-
-		subnet, err := config.clientCompute.Subnetworks.Get(project, region, subnetwork).Do()
-		if err != nil {
-			return "", fmt.Errorf(
-				"Error referencing subnetwork '%s' in region '%s': %s",
-				subnetwork, region, err)
-		}
-
-		return subnet.SelfLink, nil
-	}
-	return "", nil
-}
-
 // getNetworkName reads the "network" field from the given resource data and if the value:
 // - is a resource URL, extracts the network name from the URL and returns it
 // - is the network name only (i.e not prefixed with http://www.googleapis.com/compute/...), is returned unchanged
@@ -259,6 +218,13 @@ func optionalPrefixSuppress(prefix string) schema.SchemaDiffSuppressFunc {
 	}
 }
 
+// Suppresses the diff between an empty value and the default value the API returns for a field.
+func emptyOrDefaultStringSuppress(defaultVal string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return (old == "" && new == defaultVal) || (new == "" && old == defaultVal)
+	}
+}
+
 func ipCidrRangeDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	// The range may be a:
 	// A) single IP address (e.g. 10.2.3.4)
@@ -1,11 +1,14 @@
 package google
 
 import (
+	"encoding/base64"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"net"
 	"regexp"
+	"strconv"
+	"time"
 )
 
 const (
@@ -61,3 +64,55 @@ func validateRFC1918Network(min, max int) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// Validates a time of day in the RFC3339 "HH:MM" format, used for maintenance windows.
+func validateRFC3339Time(v interface{}, k string) (warnings []string, errors []error) {
+	time := v.(string)
+	if len(time) != 5 || time[2] != ':' {
+		errors = append(errors, fmt.Errorf("%q (%q) must be in the format HH:MM (RFC3339)", k, time))
+		return
+	}
+	if hour, err := strconv.ParseUint(time[:2], 10, 0); err != nil || hour > 23 {
+		errors = append(errors, fmt.Errorf("%q (%q) does not contain a valid hour (00-23)", k, time))
+		return
+	}
+	if min, err := strconv.ParseUint(time[3:], 10, 0); err != nil || min > 59 {
+		errors = append(errors, fmt.Errorf("%q (%q) does not contain a valid minute (00-59)", k, time))
+		return
+	}
+	return
+}
+
+// Validates a duration in the format the Google APIs use for
+// google.protobuf.Duration, i.e. seconds with up to 9 fractional digits and
+// an "s" suffix, such as "3.5s".
+func validateDuration(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^-?[0-9]+(\.[0-9]{1,9})?s$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%q) must be a duration in seconds ending in \"s\", e.g. \"3600s\"", k, value))
+	}
+	return
+}
+
+// Validates a customer-supplied AES-256 encryption key, which has to be the
+// base64 encoding of exactly 32 bytes.
+func validateBase64EncodedAES256Key(v interface{}, k string) (warnings []string, errors []error) {
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be base64 encoded: %s", k, err))
+		return
+	}
+	if len(key) != 32 {
+		errors = append(errors, fmt.Errorf("%q must be a 256 bit key, got %d bits", k, len(key)*8))
+	}
+	return
+}
+
+// Validates a point in time in the RFC3339 format, e.g. "2014-10-02T15:01:23.045123456Z".
+func validateRFC3339Timestamp(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) must be an RFC3339 timestamp: %s", k, value, err))
+	}
+	return
+}
@@ -53,6 +53,95 @@ func TestValidateRFC1918Network(t *testing.T) {
 	}
 }
 
+func TestValidateRFC3339Time(t *testing.T) {
+	cases := map[string]struct {
+		Value       string
+		ExpectError bool
+	}{
+		"midnight":            {Value: "00:00"},
+		"one minute to":       {Value: "23:59"},
+		"afternoon":           {Value: "14:30"},
+		"empty":               {Value: "", ExpectError: true},
+		"missing leading 0":   {Value: "3:30", ExpectError: true},
+		"hour out of range":   {Value: "24:00", ExpectError: true},
+		"minute out of range": {Value: "12:60", ExpectError: true},
+		"seconds":             {Value: "12:00:00", ExpectError: true},
+		"wrong separator":     {Value: "12-00", ExpectError: true},
+		"not a number":        {Value: "ab:cd", ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, es := validateRFC3339Time(tc.Value, tn)
+		if hasError := len(es) > 0; hasError != tc.ExpectError {
+			t.Errorf("bad: %s, expected error: %t, got: %v", tn, tc.ExpectError, es)
+		}
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	cases := map[string]struct {
+		Value       string
+		ExpectError bool
+	}{
+		"seconds":           {Value: "3600s"},
+		"fractional":        {Value: "1.5s"},
+		"nanoseconds":       {Value: "0.000000001s"},
+		"negative":          {Value: "-10s"},
+		"empty":             {Value: "", ExpectError: true},
+		"missing suffix":    {Value: "3600", ExpectError: true},
+		"go duration":       {Value: "1h", ExpectError: true},
+		"too many decimals": {Value: "0.0000000001s", ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, es := validateDuration(tc.Value, tn)
+		if hasError := len(es) > 0; hasError != tc.ExpectError {
+			t.Errorf("bad: %s, expected error: %t, got: %v", tn, tc.ExpectError, es)
+		}
+	}
+}
+
+func TestValidateRFC3339Timestamp(t *testing.T) {
+	cases := map[string]struct {
+		Value       string
+		ExpectError bool
+	}{
+		"utc":            {Value: "2014-10-02T15:01:23Z"},
+		"nanoseconds":    {Value: "2014-10-02T15:01:23.045123456Z"},
+		"offset":         {Value: "2014-10-02T15:01:23+02:00"},
+		"empty":          {Value: "", ExpectError: true},
+		"date only":      {Value: "2014-10-02", ExpectError: true},
+		"missing offset": {Value: "2014-10-02T15:01:23", ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, es := validateRFC3339Timestamp(tc.Value, tn)
+		if hasError := len(es) > 0; hasError != tc.ExpectError {
+			t.Errorf("bad: %s, expected error: %t, got: %v", tn, tc.ExpectError, es)
+		}
+	}
+}
+
+func TestValidateBase64EncodedAES256Key(t *testing.T) {
+	cases := map[string]struct {
+		Value       string
+		ExpectError bool
+	}{
+		"256 bit key":  {Value: "ECxgCYBwCwR9Z8DWaJVMnTeE1IyhTlGpvsTHtmDAWTs="},
+		"empty":        {Value: "", ExpectError: true},
+		"128 bit key":  {Value: "ECxgCYBwCwR9Z8DWaJVMnQ==", ExpectError: true},
+		"not base64":   {Value: "not a key!", ExpectError: true},
+		"url encoding": {Value: "ECxgCYBwCwR9Z8DWaJVMnTeE1IyhTlGpvsTHtmDAWTs_", ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, es := validateBase64EncodedAES256Key(tc.Value, tn)
+		if hasError := len(es) > 0; hasError != tc.ExpectError {
+			t.Errorf("bad: %s, expected error: %t, got: %v", tn, tc.ExpectError, es)
+		}
+	}
+}
+
 type GCPNameTestCase struct {
 	TestName    string
 	Value       string
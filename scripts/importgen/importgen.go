@@ -0,0 +1,78 @@
+// Generates Terraform configuration and `terraform import` commands for the
+// existing resources of a project.
+//
+// Supported resource types are networks, subnetworks, firewalls, instances,
+// disks, buckets, Pub/Sub topics, Cloud SQL instances and GKE clusters. Each
+// object is read through the provider's own importer and Read function, and
+// only attributes that can be set in configuration are rendered.
+//
+// The generated configuration is a starting point and should be reviewed (and
+// checked with `terraform plan` after importing) before it is relied upon.
+//
+// Usage requires credentials. Obtain via gcloud:
+//
+//   gcloud auth application-default login
+//
+// Usage example (from root dir):
+//
+//   go run ./scripts/importgen -project my-project -region us-central1
+//
+// This will write the configuration to `imported.tf` and the import commands
+// to `import.sh` in the directory from which the script is run. Use
+// -types to restrict the output to a comma-separated list of resource types.
+
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-google/google"
+)
+
+func main() {
+	project := flag.String("project", "", "project to generate configuration for")
+	region := flag.String("region", "", "default region of the provider")
+	credentials := flag.String("credentials", "", "path to or contents of a service account key file")
+	types := flag.String("types", "", "comma-separated list of resource types to generate, defaults to all supported types")
+	configFile := flag.String("config", "imported.tf", "file to write the generated configuration to")
+	scriptFile := flag.String("script", "import.sh", "file to write the import commands to")
+	flag.Parse()
+
+	if *project == "" || *region == "" {
+		flag.PrintDefaults()
+		log.Fatal("usage: go run ./scripts/importgen -project $PROJECT -region $REGION")
+	}
+
+	var resourceTypes []string
+	if *types != "" {
+		resourceTypes = strings.Split(*types, ",")
+	}
+
+	hclOut, err := os.Create(*configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer hclOut.Close()
+
+	importOut, err := os.Create(*scriptFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer importOut.Close()
+
+	if _, err := importOut.WriteString("#!/bin/sh\nset -e\n\n"); err != nil {
+		log.Fatal(err)
+	}
+
+	config := &google.Config{
+		Credentials: *credentials,
+		Project:     *project,
+		Region:      *region,
+	}
+	if err := google.GenerateImportConfig(config, resourceTypes, hclOut, importOut); err != nil {
+		log.Fatal(err)
+	}
+}